@@ -0,0 +1,48 @@
+// package algorithms provides solutions to classic algorithm problems
+package algorithms
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrNegativeAmount = errors.New("amount cannot be negative")
+	ErrInvalidCoin    = errors.New("coin values must be positive")
+	ErrNoSolution     = errors.New("amount cannot be made from the given coins")
+)
+
+// CoinChange returns the minimum number of coins needed to make amount, with an
+// unlimited supply of each coin. An amount that can't be made returns ErrNoSolution.
+//
+// It works bottom up: best[a] is the fewest coins for amount a, built from
+// best[a-coin] for every coin.
+// Time Complexity: O(amount * len(coins))
+// Space complexity: O(amount)
+func CoinChange(coins []int, amount int) (int, error) {
+	if amount < 0 {
+		return 0, ErrNegativeAmount
+	}
+	for _, c := range coins {
+		if c <= 0 {
+			return 0, fmt.Errorf("%w: got %d", ErrInvalidCoin, c)
+		}
+	}
+
+	// amount+1 coins is more than any real solution needs, so it stands in for infinity
+	unreachable := amount + 1
+	best := make([]int, amount+1)
+	for a := 1; a <= amount; a++ {
+		best[a] = unreachable
+		for _, c := range coins {
+			if c <= a && best[a-c]+1 < best[a] {
+				best[a] = best[a-c] + 1
+			}
+		}
+	}
+
+	if best[amount] == unreachable {
+		return 0, ErrNoSolution
+	}
+	return best[amount], nil
+}
@@ -0,0 +1,37 @@
+package algorithms
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCoinChange(t *testing.T) {
+	cases := []struct {
+		name    string
+		coins   []int
+		amount  int
+		want    int
+		wantErr error
+	}{
+		{name: "solvable", coins: []int{1, 2, 5}, amount: 11, want: 3},
+		{name: "greedy is not optimal", coins: []int{1, 3, 4}, amount: 6, want: 2},
+		{name: "zero amount", coins: []int{1, 2, 5}, amount: 0, want: 0},
+		{name: "impossible", coins: []int{2}, amount: 3, wantErr: ErrNoSolution},
+		{name: "no coins", coins: nil, amount: 1, wantErr: ErrNoSolution},
+		{name: "negative amount", coins: []int{1}, amount: -1, wantErr: ErrNegativeAmount},
+		{name: "invalid coin", coins: []int{1, 0}, amount: 1, wantErr: ErrInvalidCoin},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CoinChange(tt.coins, tt.amount)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("got %d want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+// package fib computes Fibonacci numbers
+package fib
+
+import (
+	"errors"
+	"math/big"
+)
+
+// MaxUint64N is the largest n whose Fibonacci number fits in a uint64
+const MaxUint64N = 93
+
+var (
+	ErrNegative = errors.New("fibonacci is not defined for negative n")
+	ErrOverflow = errors.New("fibonacci number overflows uint64, use BigFib")
+)
+
+// Fib returns the nth Fibonacci number, where Fib(0) = 0 and Fib(1) = 1.
+// n above MaxUint64N overflows and returns ErrOverflow.
+func Fib(n int) (uint64, error) {
+	if n < 0 {
+		return 0, ErrNegative
+	}
+	if n > MaxUint64N {
+		return 0, ErrOverflow
+	}
+
+	var a, b uint64 = 0, 1
+	for i := 0; i < n; i++ {
+		a, b = b, a+b
+	}
+	return a, nil
+}
+
+// BigFib returns the nth Fibonacci number with arbitrary precision.
+// It is computed iteratively so it needs O(n) additions and no recursion.
+func BigFib(n int) (*big.Int, error) {
+	if n < 0 {
+		return nil, ErrNegative
+	}
+
+	a, b := big.NewInt(0), big.NewInt(1)
+	for i := 0; i < n; i++ {
+		// a, b = b, a+b without allocating a new big.Int each step
+		a.Add(a, b)
+		a, b = b, a
+	}
+	return a, nil
+}
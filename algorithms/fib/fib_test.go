@@ -0,0 +1,68 @@
+package fib
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestFib(t *testing.T) {
+	cases := []struct {
+		n    int
+		want uint64
+	}{
+		{0, 0},
+		{1, 1},
+		{2, 1},
+		{10, 55},
+		{50, 12586269025},
+		{93, 12200160415121876738},
+	}
+
+	for _, tt := range cases {
+		got, err := Fib(tt.n)
+		if err != nil {
+			t.Fatalf("did not expect an error but got one %v", err)
+		}
+		if got != tt.want {
+			t.Errorf("Fib(%d) got %d want %d", tt.n, got, tt.want)
+		}
+	}
+
+	if _, err := Fib(MaxUint64N + 1); !errors.Is(err, ErrOverflow) {
+		t.Errorf("got error %v want %v", err, ErrOverflow)
+	}
+}
+
+func TestBigFib(t *testing.T) {
+	t.Run("matches the uint64 version", func(t *testing.T) {
+		for n := 0; n <= MaxUint64N; n++ {
+			want, _ := Fib(n)
+			got, err := BigFib(n)
+			if err != nil {
+				t.Fatalf("did not expect an error but got one %v", err)
+			}
+			if !got.IsUint64() || got.Uint64() != want {
+				t.Errorf("BigFib(%d) got %s want %d", n, got, want)
+			}
+		}
+	})
+
+	t.Run("large n", func(t *testing.T) {
+		want, _ := new(big.Int).SetString("280571172992510140037611932413038677189525", 10)
+
+		got, err := BigFib(200)
+		if err != nil {
+			t.Fatalf("did not expect an error but got one %v", err)
+		}
+		if got.Cmp(want) != 0 {
+			t.Errorf("got %s want %s", got, want)
+		}
+	})
+
+	t.Run("negative n", func(t *testing.T) {
+		if _, err := BigFib(-1); !errors.Is(err, ErrNegative) {
+			t.Errorf("got error %v want %v", err, ErrNegative)
+		}
+	})
+}
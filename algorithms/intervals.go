@@ -0,0 +1,28 @@
+package algorithms
+
+import (
+	"cmp"
+	"slices"
+)
+
+// MergeIntervals merges overlapping and touching intervals, so [1,3] and [3,5]
+// become [1,5], and returns the result sorted by start. Each interval is
+// [start, end] with start <= end. The input is not modified.
+// Time Complexity: O(n log n) for the sort
+func MergeIntervals(intervals [][2]int) [][2]int {
+	sorted := slices.Clone(intervals)
+	slices.SortFunc(sorted, func(a, b [2]int) int {
+		return cmp.Compare(a[0], b[0])
+	})
+
+	merged := make([][2]int, 0, len(sorted))
+	for _, interval := range sorted {
+		last := len(merged) - 1
+		if last >= 0 && interval[0] <= merged[last][1] {
+			merged[last][1] = max(merged[last][1], interval[1])
+			continue
+		}
+		merged = append(merged, interval)
+	}
+	return merged
+}
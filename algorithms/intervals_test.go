@@ -0,0 +1,43 @@
+package algorithms
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestMergeIntervals(t *testing.T) {
+	cases := []struct {
+		name  string
+		given [][2]int
+		want  [][2]int
+	}{
+		{"empty", [][2]int{}, [][2]int{}},
+		{"overlapping", [][2]int{{1, 3}, {2, 6}, {8, 10}, {15, 18}}, [][2]int{{1, 6}, {8, 10}, {15, 18}}},
+		{"touching", [][2]int{{1, 3}, {3, 5}}, [][2]int{{1, 5}}},
+		{"already disjoint", [][2]int{{1, 2}, {4, 5}, {7, 9}}, [][2]int{{1, 2}, {4, 5}, {7, 9}}},
+		{"unsorted input", [][2]int{{8, 10}, {1, 4}, {2, 3}}, [][2]int{{1, 4}, {8, 10}}},
+		{"contained interval", [][2]int{{1, 10}, {2, 3}, {4, 5}}, [][2]int{{1, 10}}},
+		{"extreme starts", [][2]int{{math.MinInt + 1, 0}, {9, 10}, {1, 2}}, [][2]int{{math.MinInt + 1, 0}, {1, 2}, {9, 10}}},
+		{"extreme start and end", [][2]int{{math.MaxInt, math.MaxInt}, {math.MinInt, 5}}, [][2]int{{math.MinInt, 5}, {math.MaxInt, math.MaxInt}}},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MergeIntervals(tt.given)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("input is not modified", func(t *testing.T) {
+		given := [][2]int{{3, 4}, {1, 3}}
+		MergeIntervals(given)
+
+		want := [][2]int{{3, 4}, {1, 3}}
+		if !reflect.DeepEqual(given, want) {
+			t.Errorf("got %v want %v", given, want)
+		}
+	})
+}
@@ -0,0 +1,64 @@
+package algorithms
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrMismatchedLengths = errors.New("weights and values must have the same length")
+	ErrNegativeCapacity  = errors.New("capacity cannot be negative")
+	ErrNegativeWeight    = errors.New("weights cannot be negative")
+)
+
+// Knapsack solves the 0/1 knapsack problem: pick a subset of items, each used at
+// most once, whose total weight fits in capacity and whose total value is as
+// large as possible. It returns that value and the indices of the chosen items
+// in ascending order.
+// Time Complexity: O(n * capacity) where n is the number of items
+// Space complexity: O(n * capacity) so the chosen items can be recovered
+func Knapsack(weights, values []int, capacity int) (maxValue int, chosen []int, err error) {
+	if len(weights) != len(values) {
+		return 0, nil, fmt.Errorf("%w: got %d weights and %d values", ErrMismatchedLengths, len(weights), len(values))
+	}
+	if capacity < 0 {
+		return 0, nil, ErrNegativeCapacity
+	}
+	for i, w := range weights {
+		if w < 0 {
+			return 0, nil, fmt.Errorf("%w: item %d weighs %d", ErrNegativeWeight, i, w)
+		}
+	}
+
+	n := len(weights)
+	// best[i][c] is the best value using the first i items with capacity c
+	best := make([][]int, n+1)
+	for i := range best {
+		best[i] = make([]int, capacity+1)
+	}
+
+	for i := 1; i <= n; i++ {
+		w, v := weights[i-1], values[i-1]
+		for c := 0; c <= capacity; c++ {
+			best[i][c] = best[i-1][c]
+			if w <= c && best[i-1][c-w]+v > best[i][c] {
+				best[i][c] = best[i-1][c-w] + v
+			}
+		}
+	}
+
+	// walk back through the table, an item was taken wherever the value changed
+	chosen = []int{}
+	c := capacity
+	for i := n; i > 0; i-- {
+		if best[i][c] != best[i-1][c] {
+			chosen = append(chosen, i-1)
+			c -= weights[i-1]
+		}
+	}
+	for l, r := 0, len(chosen)-1; l < r; l, r = l+1, r-1 {
+		chosen[l], chosen[r] = chosen[r], chosen[l]
+	}
+
+	return best[n][capacity], chosen, nil
+}
@@ -0,0 +1,64 @@
+package algorithms
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestKnapsack(t *testing.T) {
+	t.Run("known instance", func(t *testing.T) {
+		weights := []int{1, 3, 4, 5}
+		values := []int{1, 4, 5, 7}
+
+		got, chosen, err := Knapsack(weights, values, 7)
+		if err != nil {
+			t.Fatalf("did not expect an error but got one %v", err)
+		}
+
+		if got != 9 {
+			t.Errorf("got value %d want %d", got, 9)
+		}
+		wantChosen := []int{1, 2}
+		if !reflect.DeepEqual(chosen, wantChosen) {
+			t.Errorf("got chosen %v want %v", chosen, wantChosen)
+		}
+	})
+
+	t.Run("no item fits", func(t *testing.T) {
+		got, chosen, err := Knapsack([]int{5, 6}, []int{10, 20}, 4)
+		if err != nil {
+			t.Fatalf("did not expect an error but got one %v", err)
+		}
+
+		if got != 0 {
+			t.Errorf("got value %d want %d", got, 0)
+		}
+		if len(chosen) != 0 {
+			t.Errorf("got chosen %v want none", chosen)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		cases := []struct {
+			name     string
+			weights  []int
+			values   []int
+			capacity int
+			want     error
+		}{
+			{"mismatched lengths", []int{1, 2}, []int{1}, 5, ErrMismatchedLengths},
+			{"negative capacity", []int{1}, []int{1}, -1, ErrNegativeCapacity},
+			{"negative weight", []int{-1}, []int{1}, 5, ErrNegativeWeight},
+		}
+
+		for _, tt := range cases {
+			t.Run(tt.name, func(t *testing.T) {
+				_, _, err := Knapsack(tt.weights, tt.values, tt.capacity)
+				if !errors.Is(err, tt.want) {
+					t.Errorf("got error %v want %v", err, tt.want)
+				}
+			})
+		}
+	})
+}
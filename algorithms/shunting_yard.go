@@ -0,0 +1,122 @@
+package algorithms
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+var (
+	ErrMismatchedParens = errors.New("mismatched parentheses")
+	ErrInvalidToken     = errors.New("invalid token")
+	ErrMalformedPostfix = errors.New("malformed postfix expression")
+	ErrDivisionByZero   = errors.New("division by zero")
+)
+
+// precedence of the supported operators, all of them are left associative
+var precedence = map[string]int{
+	"+": 1,
+	"-": 1,
+	"*": 2,
+	"/": 2,
+}
+
+// InfixToPostfix converts an infix expression like ["3", "+", "4", "*", "2"] to
+// postfix (reverse Polish) notation, ["3", "4", "2", "*", "+"], using Dijkstra's
+// shunting-yard algorithm. Tokens are numbers, + - * / and parentheses.
+func InfixToPostfix(tokens []string) ([]string, error) {
+	output := make([]string, 0, len(tokens))
+	var operators []string
+
+	for _, token := range tokens {
+		switch {
+		case isNumber(token):
+			output = append(output, token)
+		case precedence[token] > 0:
+			// pop operators that bind at least as tightly, which gives left associativity
+			for len(operators) > 0 {
+				top := operators[len(operators)-1]
+				if top == "(" || precedence[top] < precedence[token] {
+					break
+				}
+				output = append(output, top)
+				operators = operators[:len(operators)-1]
+			}
+			operators = append(operators, token)
+		case token == "(":
+			operators = append(operators, token)
+		case token == ")":
+			for {
+				if len(operators) == 0 {
+					return nil, ErrMismatchedParens
+				}
+				top := operators[len(operators)-1]
+				operators = operators[:len(operators)-1]
+				if top == "(" {
+					break
+				}
+				output = append(output, top)
+			}
+		default:
+			return nil, fmt.Errorf("%w %q", ErrInvalidToken, token)
+		}
+	}
+
+	for len(operators) > 0 {
+		top := operators[len(operators)-1]
+		operators = operators[:len(operators)-1]
+		if top == "(" {
+			return nil, ErrMismatchedParens
+		}
+		output = append(output, top)
+	}
+
+	return output, nil
+}
+
+// EvalPostfix evaluates an expression in postfix notation
+func EvalPostfix(tokens []string) (float64, error) {
+	var stack []float64
+
+	for _, token := range tokens {
+		if n, err := strconv.ParseFloat(token, 64); err == nil {
+			stack = append(stack, n)
+			continue
+		}
+		if precedence[token] == 0 {
+			return 0, fmt.Errorf("%w %q", ErrInvalidToken, token)
+		}
+		if len(stack) < 2 {
+			return 0, fmt.Errorf("%w: not enough operands for %q", ErrMalformedPostfix, token)
+		}
+
+		a, b := stack[len(stack)-2], stack[len(stack)-1]
+		stack = stack[:len(stack)-2]
+
+		var result float64
+		switch token {
+		case "+":
+			result = a + b
+		case "-":
+			result = a - b
+		case "*":
+			result = a * b
+		case "/":
+			if b == 0 {
+				return 0, ErrDivisionByZero
+			}
+			result = a / b
+		}
+		stack = append(stack, result)
+	}
+
+	if len(stack) != 1 {
+		return 0, fmt.Errorf("%w: %d values left on the stack", ErrMalformedPostfix, len(stack))
+	}
+	return stack[0], nil
+}
+
+func isNumber(token string) bool {
+	_, err := strconv.ParseFloat(token, 64)
+	return err == nil
+}
@@ -0,0 +1,98 @@
+package algorithms
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestInfixToPostfix(t *testing.T) {
+	cases := []struct {
+		infix string
+		want  string
+	}{
+		{"3 + 4 * 2", "3 4 2 * +"},
+		{"( 3 + 4 ) * 2", "3 4 + 2 *"},
+		{"10 - 4 - 3", "10 4 - 3 -"},
+		{"1 + 2 * ( 3 - 4 ) / 5", "1 2 3 4 - * 5 / +"},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.infix, func(t *testing.T) {
+			got, err := InfixToPostfix(strings.Fields(tt.infix))
+			if err != nil {
+				t.Fatalf("did not expect an error but got one %v", err)
+			}
+
+			want := strings.Fields(tt.want)
+			if !slices.Equal(got, want) {
+				t.Errorf("got %v want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestEvalPostfix(t *testing.T) {
+	t.Run("evaluates a converted expression", func(t *testing.T) {
+		postfix, _ := InfixToPostfix(strings.Fields("3 + 4 * 2"))
+
+		got, err := EvalPostfix(postfix)
+		if err != nil {
+			t.Fatalf("did not expect an error but got one %v", err)
+		}
+		if got != 11 {
+			t.Errorf("got %g want %g", got, 11.0)
+		}
+	})
+
+	t.Run("left associative subtraction and division", func(t *testing.T) {
+		postfix, _ := InfixToPostfix(strings.Fields("20 / 2 / 5 - 1 - 1"))
+
+		got, _ := EvalPostfix(postfix)
+		if got != 0 {
+			t.Errorf("got %g want %g", got, 0.0)
+		}
+	})
+}
+
+func TestExpressionErrors(t *testing.T) {
+	infixCases := []struct {
+		name  string
+		infix string
+		want  error
+	}{
+		{"unclosed parenthesis", "( 3 + 4", ErrMismatchedParens},
+		{"unopened parenthesis", "3 + 4 )", ErrMismatchedParens},
+		{"invalid token", "3 % 4", ErrInvalidToken},
+	}
+
+	for _, tt := range infixCases {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := InfixToPostfix(strings.Fields(tt.infix))
+			if !errors.Is(err, tt.want) {
+				t.Errorf("got error %v want %v", err, tt.want)
+			}
+		})
+	}
+
+	postfixCases := []struct {
+		name    string
+		postfix string
+		want    error
+	}{
+		{"missing operand", "3 +", ErrMalformedPostfix},
+		{"missing operator", "3 4", ErrMalformedPostfix},
+		{"division by zero", "1 0 /", ErrDivisionByZero},
+		{"invalid token", "1 x +", ErrInvalidToken},
+	}
+
+	for _, tt := range postfixCases {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := EvalPostfix(strings.Fields(tt.postfix))
+			if !errors.Is(err, tt.want) {
+				t.Errorf("got error %v want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package stringalgo
+
+var closingToOpening = map[rune]rune{
+	')': '(',
+	']': '[',
+	'}': '{',
+}
+
+// IsBalanced reports whether the (), [] and {} brackets in s are properly matched
+// and nested. Every other character is ignored, so an empty string is balanced.
+//
+// Opening brackets are pushed on a stack and each closing bracket has to match
+// the one on top.
+func IsBalanced(s string) bool {
+	var stack []rune
+	for _, r := range s {
+		switch r {
+		case '(', '[', '{':
+			stack = append(stack, r)
+		case ')', ']', '}':
+			if len(stack) == 0 || stack[len(stack)-1] != closingToOpening[r] {
+				return false
+			}
+			stack = stack[:len(stack)-1]
+		}
+	}
+	return len(stack) == 0
+}
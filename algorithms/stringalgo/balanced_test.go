@@ -0,0 +1,30 @@
+package stringalgo
+
+import (
+	"testing"
+)
+
+func TestIsBalanced(t *testing.T) {
+	cases := []struct {
+		name  string
+		input string
+		want  bool
+	}{
+		{"empty", "", true},
+		{"simple pairs", "()[]{}", true},
+		{"nested", "{[()()]}", true},
+		{"other characters are ignored", "func main() { fmt.Println(a[0]) }", true},
+		{"wrong order", "([)]", false},
+		{"unclosed", "((", false},
+		{"closing without opening", "())", false},
+		{"starts with closing", "}{", false},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsBalanced(tt.input); got != tt.want {
+				t.Errorf("IsBalanced(%q) got %v want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
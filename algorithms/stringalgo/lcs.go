@@ -0,0 +1,54 @@
+// package stringalgo provides algorithms that operate on strings
+package stringalgo
+
+// LCSLength returns the length, in runes, of the longest common subsequence of a and b
+func LCSLength(a, b string) int {
+	table := lcsTable([]rune(a), []rune(b))
+	return table[0][0]
+}
+
+// LCS returns one longest common subsequence of a and b. When there are several
+// of the same length, which one is returned is deterministic but unspecified.
+// Time Complexity: O(n*m) where n and m are the rune lengths of a and b
+// Space complexity: O(n*m) for the DP table
+func LCS(a, b string) string {
+	ra, rb := []rune(a), []rune(b)
+	table := lcsTable(ra, rb)
+
+	// walk the table from the start, taking matches and otherwise moving
+	// towards whichever suffix still has the longer subsequence
+	result := make([]rune, 0, table[0][0])
+	i, j := 0, 0
+	for i < len(ra) && j < len(rb) {
+		switch {
+		case ra[i] == rb[j]:
+			result = append(result, ra[i])
+			i++
+			j++
+		case table[i+1][j] >= table[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+	return string(result)
+}
+
+// lcsTable builds table where table[i][j] is the LCS length of a[i:] and b[j:]
+func lcsTable(a, b []rune) [][]int {
+	table := make([][]int, len(a)+1)
+	for i := range table {
+		table[i] = make([]int, len(b)+1)
+	}
+
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				table[i][j] = table[i+1][j+1] + 1
+			} else {
+				table[i][j] = max(table[i+1][j], table[i][j+1])
+			}
+		}
+	}
+	return table
+}
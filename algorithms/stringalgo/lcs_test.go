@@ -0,0 +1,58 @@
+package stringalgo
+
+import (
+	"testing"
+)
+
+func TestLCS(t *testing.T) {
+	cases := []struct {
+		name       string
+		a, b       string
+		wantLength int
+	}{
+		{"classic", "ABCBDAB", "BDCAB", 4},
+		{"disjoint", "abc", "xyz", 0},
+		{"identical", "gopher", "gopher", 6},
+		{"empty", "", "abc", 0},
+		{"unicode", "häagen", "hagen", 5},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LCSLength(tt.a, tt.b); got != tt.wantLength {
+				t.Errorf("LCSLength got %d want %d", got, tt.wantLength)
+			}
+
+			got := LCS(tt.a, tt.b)
+			if n := len([]rune(got)); n != tt.wantLength {
+				t.Errorf("LCS returned %q of length %d, want length %d", got, n, tt.wantLength)
+			}
+			if !isSubsequence(got, tt.a) || !isSubsequence(got, tt.b) {
+				t.Errorf("LCS returned %q which is not a subsequence of both %q and %q", got, tt.a, tt.b)
+			}
+		})
+	}
+
+	t.Run("identical strings return themselves", func(t *testing.T) {
+		if got := LCS("gopher", "gopher"); got != "gopher" {
+			t.Errorf("got %q want %q", got, "gopher")
+		}
+	})
+
+	t.Run("disjoint strings return an empty result", func(t *testing.T) {
+		if got := LCS("abc", "xyz"); got != "" {
+			t.Errorf("got %q want empty string", got)
+		}
+	})
+}
+
+func isSubsequence(sub, s string) bool {
+	rs := []rune(sub)
+	i := 0
+	for _, r := range s {
+		if i < len(rs) && rs[i] == r {
+			i++
+		}
+	}
+	return i == len(rs)
+}
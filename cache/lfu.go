@@ -0,0 +1,118 @@
+// package cache provides in-memory caches with different eviction policies
+package cache
+
+import (
+	"container/list"
+)
+
+// lfuEntry is a single cached key/value along with how often it has been used
+type lfuEntry[K comparable, V any] struct {
+	key   K
+	value V
+	freq  int
+}
+
+// LFUCache is a fixed capacity cache that evicts the least frequently used entry
+// when full. Ties between entries with the same frequency are broken by evicting
+// the least recently used one.
+//
+// Entries are grouped into frequency buckets, each bucket being a list ordered
+// from most to least recently used. Tracking the lowest frequency in use lets
+// both Get and Put run in O(1).
+type LFUCache[K comparable, V any] struct {
+	capacity int
+	minFreq  int
+	items    map[K]*list.Element
+	buckets  map[int]*list.List
+}
+
+// NewLFUCache creates an LFUCache that holds at most capacity entries.
+// A capacity of zero or less produces a cache that never stores anything.
+func NewLFUCache[K comparable, V any](capacity int) *LFUCache[K, V] {
+	return &LFUCache[K, V]{
+		capacity: capacity,
+		items:    make(map[K]*list.Element),
+		buckets:  make(map[int]*list.List),
+	}
+}
+
+// Get returns the value stored for key and whether it was present.
+// A hit counts as a use of the entry.
+func (c *LFUCache[K, V]) Get(key K) (V, bool) {
+	elem, ok := c.items[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+
+	c.touch(elem)
+	return elem.Value.(*lfuEntry[K, V]).value, true
+}
+
+// Put stores value under key. Updating an existing key counts as a use of it,
+// inserting a new key into a full cache evicts an entry first.
+func (c *LFUCache[K, V]) Put(key K, value V) {
+	if c.capacity <= 0 {
+		return
+	}
+
+	if elem, ok := c.items[key]; ok {
+		elem.Value.(*lfuEntry[K, V]).value = value
+		c.touch(elem)
+		return
+	}
+
+	if len(c.items) >= c.capacity {
+		c.evict()
+	}
+
+	entry := &lfuEntry[K, V]{key: key, value: value, freq: 1}
+	c.items[key] = c.bucket(1).PushFront(entry)
+	c.minFreq = 1
+}
+
+// Len returns the number of entries currently in the cache
+func (c *LFUCache[K, V]) Len() int {
+	return len(c.items)
+}
+
+// touch moves an entry up into the next frequency bucket
+func (c *LFUCache[K, V]) touch(elem *list.Element) {
+	entry := elem.Value.(*lfuEntry[K, V])
+
+	old := c.buckets[entry.freq]
+	old.Remove(elem)
+	if old.Len() == 0 {
+		delete(c.buckets, entry.freq)
+		if c.minFreq == entry.freq {
+			c.minFreq++
+		}
+	}
+
+	entry.freq++
+	c.items[entry.key] = c.bucket(entry.freq).PushFront(entry)
+}
+
+// evict drops the least recently used entry from the lowest frequency bucket
+func (c *LFUCache[K, V]) evict() {
+	bucket, ok := c.buckets[c.minFreq]
+	if !ok {
+		return
+	}
+
+	elem := bucket.Back()
+	bucket.Remove(elem)
+	if bucket.Len() == 0 {
+		delete(c.buckets, c.minFreq)
+	}
+	delete(c.items, elem.Value.(*lfuEntry[K, V]).key)
+}
+
+func (c *LFUCache[K, V]) bucket(freq int) *list.List {
+	b, ok := c.buckets[freq]
+	if !ok {
+		b = list.New()
+		c.buckets[freq] = b
+	}
+	return b
+}
@@ -0,0 +1,86 @@
+package cache
+
+import (
+	"testing"
+)
+
+func TestLFUCache(t *testing.T) {
+	t.Run("get returns stored values", func(t *testing.T) {
+		c := NewLFUCache[string, int](2)
+		c.Put("a", 1)
+
+		assertHit(t, c, "a", 1)
+		assertMiss(t, c, "b")
+	})
+
+	t.Run("frequently used key survives eviction", func(t *testing.T) {
+		c := NewLFUCache[string, int](2)
+		c.Put("hot", 1)
+		c.Put("cold", 2)
+
+		c.Get("hot")
+		c.Get("hot")
+
+		c.Put("new", 3)
+
+		assertHit(t, c, "hot", 1)
+		assertHit(t, c, "new", 3)
+		assertMiss(t, c, "cold")
+	})
+
+	t.Run("ties are broken by least recently used", func(t *testing.T) {
+		c := NewLFUCache[string, int](2)
+		c.Put("a", 1)
+		c.Put("b", 2)
+
+		// both keys now have a frequency of 2, but "a" was used longest ago
+		c.Get("a")
+		c.Get("b")
+
+		c.Put("c", 3)
+
+		assertMiss(t, c, "a")
+		assertHit(t, c, "b", 2)
+		assertHit(t, c, "c", 3)
+	})
+
+	t.Run("updating a key counts as a use", func(t *testing.T) {
+		c := NewLFUCache[string, int](2)
+		c.Put("a", 1)
+		c.Put("b", 2)
+		c.Put("a", 10)
+
+		c.Put("c", 3)
+
+		assertHit(t, c, "a", 10)
+		assertMiss(t, c, "b")
+		if c.Len() != 2 {
+			t.Errorf("got len %d want %d", c.Len(), 2)
+		}
+	})
+
+	t.Run("zero capacity stores nothing", func(t *testing.T) {
+		c := NewLFUCache[string, int](0)
+		c.Put("a", 1)
+
+		assertMiss(t, c, "a")
+	})
+}
+
+func assertHit(t testing.TB, c *LFUCache[string, int], key string, want int) {
+	t.Helper()
+	got, ok := c.Get(key)
+	if !ok {
+		t.Fatalf("expected %q to be cached", key)
+	}
+	if got != want {
+		t.Errorf("got %d want %d for %q", got, want, key)
+	}
+}
+
+func assertMiss(t testing.TB, c *LFUCache[string, int], key string) {
+	t.Helper()
+	if _, ok := c.Get(key); ok {
+		t.Errorf("expected %q to not be cached", key)
+	}
+}
@@ -0,0 +1,75 @@
+// package events provides a simple publish/subscribe event bus
+package events
+
+import (
+	"sync"
+)
+
+// Bus delivers every published value to all current subscribers.
+//
+// Delivery policy: each subscriber gets its own channel buffered to the size
+// given to NewBus. Publish never blocks, if a subscriber's buffer is full the
+// value is dropped for that subscriber only. Slow subscribers therefore miss
+// events rather than holding up the publisher.
+type Bus[T any] struct {
+	mu     sync.RWMutex
+	buffer int
+	subs   []chan T
+	closed bool
+}
+
+// NewBus creates a Bus whose subscriber channels hold up to buffer pending values
+func NewBus[T any](buffer int) *Bus[T] {
+	if buffer < 0 {
+		buffer = 0
+	}
+	return &Bus[T]{buffer: buffer}
+}
+
+// Subscribe returns a channel receiving every value published from now on.
+// The channel is closed when the bus is closed, subscribing to a closed bus
+// returns an already closed channel.
+func (b *Bus[T]) Subscribe() <-chan T {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	ch := make(chan T, b.buffer)
+	if b.closed {
+		close(ch)
+		return ch
+	}
+	b.subs = append(b.subs, ch)
+	return ch
+}
+
+// Publish sends v to every subscriber without blocking. Publishing to a closed bus does nothing.
+func (b *Bus[T]) Publish(v T) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if b.closed {
+		return
+	}
+	for _, ch := range b.subs {
+		select {
+		case ch <- v:
+		default:
+			// subscriber is full, drop the value for them
+		}
+	}
+}
+
+// Close closes every subscription channel. Calling Close more than once is safe.
+func (b *Bus[T]) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return
+	}
+	b.closed = true
+	for _, ch := range b.subs {
+		close(ch)
+	}
+	b.subs = nil
+}
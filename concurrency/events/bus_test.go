@@ -0,0 +1,85 @@
+package events
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// run with 'go test -race' to make sure the bus is safe
+func TestBus(t *testing.T) {
+	t.Run("every subscriber receives a published value", func(t *testing.T) {
+		bus := NewBus[string](1)
+		defer bus.Close()
+
+		subs := []<-chan string{bus.Subscribe(), bus.Subscribe(), bus.Subscribe()}
+		bus.Publish("hello")
+
+		for i, sub := range subs {
+			select {
+			case got := <-sub:
+				if got != "hello" {
+					t.Errorf("subscriber %d got %q want %q", i, got, "hello")
+				}
+			case <-time.After(time.Second):
+				t.Fatalf("subscriber %d never received the value", i)
+			}
+		}
+	})
+
+	t.Run("publish drops values for a full subscriber", func(t *testing.T) {
+		bus := NewBus[int](1)
+		defer bus.Close()
+
+		sub := bus.Subscribe()
+		bus.Publish(1)
+		bus.Publish(2)
+
+		if got := <-sub; got != 1 {
+			t.Errorf("got %d want %d", got, 1)
+		}
+		select {
+		case got := <-sub:
+			t.Errorf("expected the second value to be dropped but got %d", got)
+		default:
+		}
+	})
+
+	t.Run("close terminates all subscriptions", func(t *testing.T) {
+		bus := NewBus[int](0)
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			sub := bus.Subscribe()
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for range sub {
+				}
+			}()
+		}
+
+		go func() {
+			for i := 0; i < 100; i++ {
+				bus.Publish(i)
+			}
+		}()
+		bus.Close()
+
+		done := make(chan struct{})
+		go func() {
+			wg.Wait()
+			close(done)
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("subscribers were not terminated by Close")
+		}
+
+		if _, ok := <-bus.Subscribe(); ok {
+			t.Error("expected subscribing to a closed bus to return a closed channel")
+		}
+	})
+}
@@ -0,0 +1,76 @@
+// package pipeline provides helpers for wiring goroutines together with channels
+package pipeline
+
+import (
+	"context"
+	"sync"
+)
+
+// FanIn merges channels into a single channel. Values from one input keep their
+// order, values from different inputs are interleaved in whatever order they
+// arrive. The returned channel is closed once every input has been closed.
+func FanIn[T any](channels ...<-chan T) <-chan T {
+	out := make(chan T)
+
+	var wg sync.WaitGroup
+	wg.Add(len(channels))
+	for _, ch := range channels {
+		go func(ch <-chan T) {
+			defer wg.Done()
+			for v := range ch {
+				out <- v
+			}
+		}(ch)
+	}
+
+	// close out only after every forwarding goroutine is done sending to it
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+
+	return out
+}
+
+// Generate returns a channel that yields items in order and is closed afterwards.
+// If ctx is cancelled it stops early and closes the channel without sending the rest.
+func Generate[T any](ctx context.Context, items ...T) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for _, item := range items {
+			select {
+			case out <- item:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// Stage applies fn to every value from in and sends the results, in order, on the
+// returned channel, which is closed once in is closed. If ctx is cancelled it stops
+// early and closes the channel, so a cancelled pipeline winds down stage by stage.
+func Stage[T, U any](ctx context.Context, in <-chan T, fn func(T) U) <-chan U {
+	out := make(chan U)
+	go func() {
+		defer close(out)
+		for {
+			select {
+			case v, ok := <-in:
+				if !ok {
+					return
+				}
+				select {
+				case out <- fn(v):
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
@@ -0,0 +1,132 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+)
+
+// run with 'go test -race' to make sure the merge is safe
+func TestFanIn(t *testing.T) {
+	t.Run("every value arrives and the output closes", func(t *testing.T) {
+		const perChannel = 100
+		inputs := make([]<-chan int, 3)
+		for i := range inputs {
+			ch := make(chan int)
+			inputs[i] = ch
+			go func(base int) {
+				defer close(ch)
+				for j := 0; j < perChannel; j++ {
+					ch <- base + j
+				}
+			}(i * 1000)
+		}
+
+		var got []int
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			for v := range FanIn(inputs...) {
+				got = append(got, v)
+			}
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("merged channel was not closed")
+		}
+
+		var want []int
+		for i := range inputs {
+			for j := 0; j < perChannel; j++ {
+				want = append(want, i*1000+j)
+			}
+		}
+		slices.Sort(got)
+		if !slices.Equal(got, want) {
+			t.Errorf("got %v want %v", got, want)
+		}
+	})
+
+	t.Run("no inputs closes straight away", func(t *testing.T) {
+		select {
+		case _, ok := <-FanIn[int]():
+			if ok {
+				t.Error("expected the merged channel to be closed")
+			}
+		case <-time.After(time.Second):
+			t.Fatal("merged channel was not closed")
+		}
+	})
+}
+
+func TestGenerateStage(t *testing.T) {
+	t.Run("generator into a doubling stage", func(t *testing.T) {
+		ctx := context.Background()
+		double := func(n int) int { return n * 2 }
+
+		var got []int
+		for v := range Stage(ctx, Generate(ctx, 1, 2, 3, 4), double) {
+			got = append(got, v)
+		}
+
+		want := []int{2, 4, 6, 8}
+		if !slices.Equal(got, want) {
+			t.Errorf("got %v want %v", got, want)
+		}
+	})
+
+	t.Run("stages compose and change type", func(t *testing.T) {
+		ctx := context.Background()
+		square := func(n int) int { return n * n }
+		label := func(n int) string { return fmt.Sprintf("#%d", n) }
+
+		var got []string
+		for v := range Stage(ctx, Stage(ctx, Generate(ctx, 1, 2, 3), square), label) {
+			got = append(got, v)
+		}
+
+		want := []string{"#1", "#4", "#9"}
+		if !slices.Equal(got, want) {
+			t.Errorf("got %v want %v", got, want)
+		}
+	})
+
+	t.Run("cancellation stops the pipeline early", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		items := make([]int, 1000)
+		for i := range items {
+			items[i] = i
+		}
+		out := Stage(ctx, Generate(ctx, items...), func(n int) int { return n })
+
+		// take a few values then cancel, the rest must never arrive
+		for i := 0; i < 3; i++ {
+			<-out
+		}
+		cancel()
+
+		received := 3
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			for range out {
+				received++
+			}
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("pipeline did not stop after cancel")
+		}
+		if received >= len(items) {
+			t.Errorf("received all %d items, expected the pipeline to stop early", received)
+		}
+	})
+}
@@ -0,0 +1,51 @@
+// package pool provides a bounded worker pool for running tasks concurrently
+package pool
+
+import (
+	"sync"
+)
+
+// WorkerPool runs submitted tasks on a fixed number of goroutines.
+// At most size tasks run at the same time and at most size more can be queued,
+// after which Submit blocks until a worker frees up.
+type WorkerPool struct {
+	tasks chan func()
+	wg    sync.WaitGroup
+}
+
+// NewWorkerPool starts a pool with size workers. A size below one is treated as one.
+func NewWorkerPool(size int) *WorkerPool {
+	if size < 1 {
+		size = 1
+	}
+
+	p := &WorkerPool{tasks: make(chan func(), size)}
+	for i := 0; i < size; i++ {
+		go p.work()
+	}
+	return p
+}
+
+// Submit queues a task to be run by one of the workers, blocking while the queue is full
+func (p *WorkerPool) Submit(task func()) {
+	p.wg.Add(1)
+	p.tasks <- task
+}
+
+// Wait blocks until every task submitted so far has finished
+func (p *WorkerPool) Wait() {
+	p.wg.Wait()
+}
+
+// Close stops the workers once the queued tasks have drained.
+// Submitting after Close panics.
+func (p *WorkerPool) Close() {
+	close(p.tasks)
+}
+
+func (p *WorkerPool) work() {
+	for task := range p.tasks {
+		task()
+		p.wg.Done()
+	}
+}
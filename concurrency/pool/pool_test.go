@@ -0,0 +1,77 @@
+package pool
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	counter "github.com/aziz-shoko/dsa-go/documents/testing/sync"
+)
+
+// run with 'go test -race' to make sure the pool is safe
+func TestWorkerPool(t *testing.T) {
+	t.Run("runs every submitted task", func(t *testing.T) {
+		wantedCount := 1000
+		size := 4
+		p := NewWorkerPool(size)
+		defer p.Close()
+
+		c := counter.NewCounter()
+		var running, maxRunning int64
+
+		for i := 0; i < wantedCount; i++ {
+			p.Submit(func() {
+				now := atomic.AddInt64(&running, 1)
+				for {
+					seen := atomic.LoadInt64(&maxRunning)
+					if now <= seen || atomic.CompareAndSwapInt64(&maxRunning, seen, now) {
+						break
+					}
+				}
+
+				c.Inc()
+				atomic.AddInt64(&running, -1)
+			})
+		}
+		p.Wait()
+
+		if c.Value() != wantedCount {
+			t.Errorf("got %d, want %d", c.Value(), wantedCount)
+		}
+		if maxRunning > int64(size) {
+			t.Errorf("had %d tasks running at once, want at most %d", maxRunning, size)
+		}
+	})
+
+	t.Run("submit blocks while the queue is full", func(t *testing.T) {
+		p := NewWorkerPool(1)
+		defer p.Close()
+
+		release := make(chan struct{})
+		started := make(chan struct{})
+
+		// one task occupies the worker, one fills the queue
+		p.Submit(func() {
+			close(started)
+			<-release
+		})
+		<-started
+		p.Submit(func() {})
+
+		submitted := make(chan struct{})
+		go func() {
+			p.Submit(func() {})
+			close(submitted)
+		}()
+
+		select {
+		case <-submitted:
+			t.Fatal("expected Submit to block while the queue is full")
+		case <-time.After(20 * time.Millisecond):
+		}
+
+		close(release)
+		<-submitted
+		p.Wait()
+	})
+}
@@ -0,0 +1,84 @@
+// package linkedlist provides a generic singly linked list
+package linkedlist
+
+type node[T any] struct {
+	value T
+	next  *node[T]
+}
+
+// List is a singly linked list. It keeps a pointer to both ends so PushFront,
+// PushBack and PopFront are all O(1), which makes it usable as a queue.
+// The zero value is an empty list ready to use.
+type List[T any] struct {
+	head *node[T]
+	tail *node[T]
+	len  int
+}
+
+// PushFront adds v to the start of the list
+func (l *List[T]) PushFront(v T) {
+	n := &node[T]{value: v, next: l.head}
+	l.head = n
+	if l.tail == nil {
+		l.tail = n
+	}
+	l.len++
+}
+
+// PushBack adds v to the end of the list
+func (l *List[T]) PushBack(v T) {
+	n := &node[T]{value: v}
+	if l.tail == nil {
+		l.head = n
+	} else {
+		l.tail.next = n
+	}
+	l.tail = n
+	l.len++
+}
+
+// PopFront removes and returns the first element, or false if the list is empty
+func (l *List[T]) PopFront() (T, bool) {
+	if l.head == nil {
+		var zero T
+		return zero, false
+	}
+
+	n := l.head
+	l.head = n.next
+	if l.head == nil {
+		l.tail = nil
+	}
+	l.len--
+	return n.value, true
+}
+
+// Len returns the number of elements in the list
+func (l *List[T]) Len() int {
+	return l.len
+}
+
+// ToSlice returns the elements from front to back in a new slice
+func (l *List[T]) ToSlice() []T {
+	values := make([]T, 0, l.len)
+	for n := l.head; n != nil; n = n.next {
+		values = append(values, n.value)
+	}
+	return values
+}
+
+// Reverse reverses the list in place by pointing every node at the one before it,
+// no values are copied.
+// Time Complexity: O(n)
+func (l *List[T]) Reverse() {
+	var prev *node[T]
+	curr := l.head
+	// the old head ends up last
+	l.tail = curr
+	for curr != nil {
+		next := curr.next
+		curr.next = prev
+		prev, curr = curr, next
+	}
+	l.head = prev
+}
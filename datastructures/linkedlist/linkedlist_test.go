@@ -0,0 +1,115 @@
+package linkedlist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPushAndPop(t *testing.T) {
+	t.Run("push back keeps insertion order", func(t *testing.T) {
+		var l List[int]
+		l.PushBack(1)
+		l.PushBack(2)
+		l.PushBack(3)
+
+		assertList(t, &l, []int{1, 2, 3})
+	})
+
+	t.Run("push front prepends", func(t *testing.T) {
+		var l List[int]
+		l.PushFront(1)
+		l.PushFront(2)
+		l.PushBack(3)
+
+		assertList(t, &l, []int{2, 1, 3})
+	})
+
+	t.Run("pop front works as a queue", func(t *testing.T) {
+		var l List[string]
+		l.PushBack("a")
+		l.PushBack("b")
+
+		got, ok := l.PopFront()
+		if !ok || got != "a" {
+			t.Errorf("got %q, %t want a, true", got, ok)
+		}
+		got, ok = l.PopFront()
+		if !ok || got != "b" {
+			t.Errorf("got %q, %t want b, true", got, ok)
+		}
+		_, ok = l.PopFront()
+		if ok {
+			t.Error("expected popping an empty list to return false")
+		}
+
+		// the list must still work after being emptied
+		l.PushBack("c")
+		assertList(t, &l, []string{"c"})
+	})
+}
+
+func TestReverse(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		want  []int
+	}{
+		{"empty list", nil, []int{}},
+		{"single element", []int{1}, []int{1}},
+		{"two elements", []int{1, 2}, []int{2, 1}},
+		{"many elements", []int{1, 2, 3, 4, 5}, []int{5, 4, 3, 2, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var l List[int]
+			var nodes []*node[int]
+			for _, v := range tt.input {
+				l.PushBack(v)
+				nodes = append(nodes, l.tail)
+			}
+
+			l.Reverse()
+
+			assertList(t, &l, tt.want)
+
+			// the same nodes are relinked, in the opposite order
+			i := len(nodes) - 1
+			for n := l.head; n != nil; n = n.next {
+				if n != nodes[i] {
+					t.Fatalf("node %d was replaced rather than relinked", i)
+				}
+				i--
+			}
+
+			// the tail must be fixed up too, so appending still works
+			l.PushBack(99)
+			assertList(t, &l, append(tt.want, 99))
+		})
+	}
+}
+
+// assertList checks the contents, the length and that walking the nodes finds exactly Len of them
+func assertList[T any](t testing.TB, l *List[T], want []T) {
+	t.Helper()
+
+	if got := l.ToSlice(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v want %v", got, want)
+	}
+	if l.Len() != len(want) {
+		t.Errorf("got Len %d want %d", l.Len(), len(want))
+	}
+
+	count := 0
+	var last *node[T]
+	for n := l.head; n != nil; n = n.next {
+		count++
+		last = n
+	}
+	if count != len(want) {
+		t.Errorf("walked %d nodes want %d", count, len(want))
+	}
+	if last != l.tail {
+		t.Error("tail does not point at the last node")
+	}
+}
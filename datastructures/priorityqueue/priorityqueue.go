@@ -0,0 +1,66 @@
+// package priorityqueue provides a generic priority queue ordered by a less function
+package priorityqueue
+
+import (
+	"container/heap"
+)
+
+// items implements heap.Interface, it is kept unexported so callers only see the typed API
+type items[T any] struct {
+	values []T
+	less   func(a, b T) bool
+}
+
+func (h *items[T]) Len() int           { return len(h.values) }
+func (h *items[T]) Less(i, j int) bool { return h.less(h.values[i], h.values[j]) }
+func (h *items[T]) Swap(i, j int)      { h.values[i], h.values[j] = h.values[j], h.values[i] }
+func (h *items[T]) Push(x any)         { h.values = append(h.values, x.(T)) }
+
+func (h *items[T]) Pop() any {
+	n := len(h.values)
+	v := h.values[n-1]
+	var zero T
+	h.values[n-1] = zero
+	h.values = h.values[:n-1]
+	return v
+}
+
+// PriorityQueue is a binary heap backed by container/heap. The element for which
+// less reports true against every other is popped first, so a less of a < b
+// gives a min priority queue. Push and Pop are O(log n), Peek is O(1).
+type PriorityQueue[T any] struct {
+	heap items[T]
+}
+
+// New creates an empty PriorityQueue ordered by less
+func New[T any](less func(a, b T) bool) *PriorityQueue[T] {
+	return &PriorityQueue[T]{heap: items[T]{less: less}}
+}
+
+// Push adds v to the queue
+func (pq *PriorityQueue[T]) Push(v T) {
+	heap.Push(&pq.heap, v)
+}
+
+// Pop removes and returns the highest priority element, or false if the queue is empty
+func (pq *PriorityQueue[T]) Pop() (T, bool) {
+	if pq.heap.Len() == 0 {
+		var zero T
+		return zero, false
+	}
+	return heap.Pop(&pq.heap).(T), true
+}
+
+// Peek returns the highest priority element without removing it, or false if the queue is empty
+func (pq *PriorityQueue[T]) Peek() (T, bool) {
+	if pq.heap.Len() == 0 {
+		var zero T
+		return zero, false
+	}
+	return pq.heap.values[0], true
+}
+
+// Len returns the number of elements in the queue
+func (pq *PriorityQueue[T]) Len() int {
+	return pq.heap.Len()
+}
@@ -0,0 +1,70 @@
+package priorityqueue
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPriorityQueue(t *testing.T) {
+	t.Run("min priority ints", func(t *testing.T) {
+		pq := New(func(a, b int) bool { return a < b })
+		for _, v := range []int{5, 1, 8, 3, 1, 9, 2} {
+			pq.Push(v)
+		}
+
+		if got, ok := pq.Peek(); !ok || got != 1 {
+			t.Errorf("Peek() = %d, %t want 1, true", got, ok)
+		}
+
+		got := drain(pq)
+		want := []int{1, 1, 2, 3, 5, 8, 9}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got %v want %v", got, want)
+		}
+	})
+
+	t.Run("struct with a priority field", func(t *testing.T) {
+		type task struct {
+			Name     string
+			Priority int
+		}
+
+		// higher priority first
+		pq := New(func(a, b task) bool { return a.Priority > b.Priority })
+		pq.Push(task{"write tests", 2})
+		pq.Push(task{"fix outage", 10})
+		pq.Push(task{"lunch", 1})
+		pq.Push(task{"review", 5})
+
+		got := drain(pq)
+		want := []task{
+			{"fix outage", 10},
+			{"review", 5},
+			{"write tests", 2},
+			{"lunch", 1},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got %v want %v", got, want)
+		}
+	})
+
+	t.Run("empty queue returns ok false", func(t *testing.T) {
+		pq := New(func(a, b int) bool { return a < b })
+
+		if _, ok := pq.Pop(); ok {
+			t.Error("expected Pop on an empty queue to return false")
+		}
+		if _, ok := pq.Peek(); ok {
+			t.Error("expected Peek on an empty queue to return false")
+		}
+	})
+}
+
+func drain[T any](pq *PriorityQueue[T]) []T {
+	var out []T
+	for pq.Len() > 0 {
+		v, _ := pq.Pop()
+		out = append(out, v)
+	}
+	return out
+}
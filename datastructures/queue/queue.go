@@ -0,0 +1,68 @@
+// package queue provides a generic first in first out queue
+package queue
+
+// minCapacity is the size of the buffer allocated by the first Enqueue
+const minCapacity = 8
+
+// Queue is a first in first out queue backed by a circular buffer. Unlike
+// reslicing with s = s[1:], dequeued slots are reused so the backing array
+// doesn't keep growing, and they are zeroed so the values can be garbage collected.
+// The buffer doubles when full, so Enqueue and Dequeue are amortized O(1).
+// The zero value is an empty queue ready to use.
+type Queue[T any] struct {
+	buf  []T
+	head int // index of the front element
+	len  int
+}
+
+// Enqueue adds v to the back of the queue
+func (q *Queue[T]) Enqueue(v T) {
+	if q.len == len(q.buf) {
+		q.grow()
+	}
+	q.buf[(q.head+q.len)%len(q.buf)] = v
+	q.len++
+}
+
+// Dequeue removes and returns the front element, or the zero value and false if the queue is empty
+func (q *Queue[T]) Dequeue() (T, bool) {
+	var zero T
+	if q.len == 0 {
+		return zero, false
+	}
+
+	v := q.buf[q.head]
+	q.buf[q.head] = zero
+	q.head = (q.head + 1) % len(q.buf)
+	q.len--
+	return v, true
+}
+
+// Peek returns the front element without removing it, or the zero value and false if the queue is empty
+func (q *Queue[T]) Peek() (T, bool) {
+	if q.len == 0 {
+		var zero T
+		return zero, false
+	}
+	return q.buf[q.head], true
+}
+
+// Len returns the number of elements in the queue
+func (q *Queue[T]) Len() int {
+	return q.len
+}
+
+// grow doubles the buffer, unwrapping the elements so the front is at index 0 again
+func (q *Queue[T]) grow() {
+	capacity := len(q.buf) * 2
+	if capacity == 0 {
+		capacity = minCapacity
+	}
+
+	buf := make([]T, capacity)
+	// the elements may wrap around the end, copy the part up to the end then the part from the start
+	n := copy(buf, q.buf[q.head:])
+	copy(buf[n:], q.buf[:q.head])
+	q.buf = buf
+	q.head = 0
+}
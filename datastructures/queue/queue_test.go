@@ -0,0 +1,105 @@
+package queue
+
+import (
+	"testing"
+)
+
+func TestQueue(t *testing.T) {
+	t.Run("first in first out", func(t *testing.T) {
+		var q Queue[int]
+		q.Enqueue(1)
+		q.Enqueue(2)
+		q.Enqueue(3)
+
+		for _, want := range []int{1, 2, 3} {
+			got, ok := q.Dequeue()
+			if !ok || got != want {
+				t.Errorf("got %d, %t want %d, true", got, ok, want)
+			}
+		}
+	})
+
+	t.Run("peek does not remove the front", func(t *testing.T) {
+		var q Queue[string]
+		q.Enqueue("a")
+		q.Enqueue("b")
+
+		got, ok := q.Peek()
+		if !ok || got != "a" {
+			t.Errorf("got %q, %t want a, true", got, ok)
+		}
+		if q.Len() != 2 {
+			t.Errorf("got Len %d want 2", q.Len())
+		}
+	})
+
+	t.Run("empty queue returns ok false", func(t *testing.T) {
+		var q Queue[int]
+
+		if _, ok := q.Dequeue(); ok {
+			t.Error("expected Dequeue on an empty queue to return false")
+		}
+		if _, ok := q.Peek(); ok {
+			t.Error("expected Peek on an empty queue to return false")
+		}
+	})
+}
+
+func TestQueueWrapsAndGrows(t *testing.T) {
+	var q Queue[int]
+	next, expected := 0, 0
+
+	// enqueue three for every two dequeued, so the head keeps wrapping around
+	// the buffer while the queue slowly grows through several resizes
+	for round := 0; round < 20000; round++ {
+		for i := 0; i < 3; i++ {
+			q.Enqueue(next)
+			next++
+		}
+		for i := 0; i < 2; i++ {
+			got, ok := q.Dequeue()
+			if !ok || got != expected {
+				t.Fatalf("round %d: got %d, %t want %d, true", round, got, ok, expected)
+			}
+			expected++
+		}
+	}
+
+	if q.Len() != 20000 {
+		t.Fatalf("got Len %d want 20000", q.Len())
+	}
+	if len(q.buf) < q.Len() {
+		t.Fatalf("buffer of %d can't hold %d elements", len(q.buf), q.Len())
+	}
+
+	// drain what's left, still in order
+	for q.Len() > 0 {
+		got, _ := q.Dequeue()
+		if got != expected {
+			t.Fatalf("got %d want %d", got, expected)
+		}
+		expected++
+	}
+	if expected != next {
+		t.Errorf("dequeued %d elements want %d", expected, next)
+	}
+}
+
+func TestDequeueZeroesSlot(t *testing.T) {
+	var q Queue[*int]
+	for i := 0; i < 5; i++ {
+		v := i
+		q.Enqueue(&v)
+	}
+
+	q.Dequeue()
+	q.Dequeue()
+
+	for i, p := range q.buf {
+		// the two dequeued slots at the front, and the unused tail, must not hold pointers
+		live := i >= q.head && i < q.head+q.Len()
+		if !live && p != nil {
+			t.Errorf("slot %d still holds a pointer after being dequeued", i)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+// package stack provides a generic last in first out stack
+package stack
+
+// Stack is a last in first out stack backed by a slice.
+// The zero value is an empty stack ready to use.
+type Stack[T any] struct {
+	items []T
+}
+
+// Push adds v to the top of the stack
+func (s *Stack[T]) Push(v T) {
+	s.items = append(s.items, v)
+}
+
+// Pop removes and returns the top element, or the zero value and false if the stack is empty
+func (s *Stack[T]) Pop() (T, bool) {
+	if len(s.items) == 0 {
+		var zero T
+		return zero, false
+	}
+
+	last := len(s.items) - 1
+	v := s.items[last]
+	// clear the slot so the popped value can be garbage collected
+	var zero T
+	s.items[last] = zero
+	s.items = s.items[:last]
+	return v, true
+}
+
+// Peek returns the top element without removing it, or the zero value and false if the stack is empty
+func (s *Stack[T]) Peek() (T, bool) {
+	if len(s.items) == 0 {
+		var zero T
+		return zero, false
+	}
+	return s.items[len(s.items)-1], true
+}
+
+// Len returns the number of elements on the stack
+func (s *Stack[T]) Len() int {
+	return len(s.items)
+}
+
+// IsEmpty reports whether the stack has no elements
+func (s *Stack[T]) IsEmpty() bool {
+	return len(s.items) == 0
+}
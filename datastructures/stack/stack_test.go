@@ -0,0 +1,57 @@
+package stack
+
+import (
+	"testing"
+)
+
+func TestStack(t *testing.T) {
+	t.Run("pops in last in first out order", func(t *testing.T) {
+		var s Stack[int]
+		s.Push(1)
+		s.Push(2)
+		s.Push(3)
+
+		for _, want := range []int{3, 2, 1} {
+			got, ok := s.Pop()
+			assertValue(t, got, ok, want, true)
+		}
+		if !s.IsEmpty() {
+			t.Error("expected the stack to be empty")
+		}
+	})
+
+	t.Run("peek does not remove the top", func(t *testing.T) {
+		var s Stack[string]
+		s.Push("a")
+		s.Push("b")
+
+		got, ok := s.Peek()
+		assertValue(t, got, ok, "b", true)
+		got, ok = s.Peek()
+		assertValue(t, got, ok, "b", true)
+
+		if s.Len() != 2 {
+			t.Errorf("got Len %d want 2", s.Len())
+		}
+	})
+
+	t.Run("empty stack returns ok false", func(t *testing.T) {
+		var s Stack[int]
+
+		got, ok := s.Pop()
+		assertValue(t, got, ok, 0, false)
+		got, ok = s.Peek()
+		assertValue(t, got, ok, 0, false)
+
+		if !s.IsEmpty() || s.Len() != 0 {
+			t.Errorf("got IsEmpty %t Len %d want true 0", s.IsEmpty(), s.Len())
+		}
+	})
+}
+
+func assertValue[T comparable](t testing.TB, got T, gotOK bool, want T, wantOK bool) {
+	t.Helper()
+	if got != want || gotOK != wantOK {
+		t.Errorf("got %v, %t want %v, %t", got, gotOK, want, wantOK)
+	}
+}
@@ -0,0 +1,31 @@
+package arraysandslices
+
+// Reduce folds s from left to right, starting from init
+func Reduce[T, U any](s []T, init U, f func(U, T) U) U {
+	result := init
+	for _, x := range s {
+		result = f(result, x)
+	}
+	return result
+}
+
+// ReduceRight folds s from right to left, starting from init
+func ReduceRight[T, U any](s []T, init U, f func(U, T) U) U {
+	result := init
+	for i := len(s) - 1; i >= 0; i-- {
+		result = f(result, s[i])
+	}
+	return result
+}
+
+// Scan is Reduce that keeps every intermediate result: the ith element is the
+// fold of s[:i+1]. init itself is not included, so the result has len(s) elements.
+func Scan[T, U any](s []T, init U, f func(U, T) U) []U {
+	results := make([]U, 0, len(s))
+	acc := init
+	for _, x := range s {
+		acc = f(acc, x)
+		results = append(results, acc)
+	}
+	return results
+}
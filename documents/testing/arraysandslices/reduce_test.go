@@ -0,0 +1,61 @@
+package arraysandslices
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestReduce(t *testing.T) {
+	concat := func(acc, s string) string { return acc + s }
+
+	t.Run("reduce folds from the left", func(t *testing.T) {
+		got := Reduce([]string{"a", "b", "c"}, "", concat)
+		assertString(t, got, "abc")
+	})
+
+	t.Run("reduce right folds from the right", func(t *testing.T) {
+		got := ReduceRight([]string{"a", "b", "c"}, "", concat)
+		assertString(t, got, "cba")
+	})
+
+	t.Run("empty slices return init", func(t *testing.T) {
+		assertString(t, Reduce([]string{}, "init", concat), "init")
+		assertString(t, ReduceRight([]string{}, "init", concat), "init")
+	})
+}
+
+func TestScan(t *testing.T) {
+	add := func(acc, x int) int { return acc + x }
+
+	t.Run("running sums", func(t *testing.T) {
+		got := Scan([]int{1, 2, 3, 4}, 0, add)
+		want := []int{1, 3, 6, 10}
+
+		if !slices.Equal(got, want) {
+			t.Errorf("got %v want %v", got, want)
+		}
+	})
+
+	t.Run("last element matches reduce", func(t *testing.T) {
+		given := []int{5, -2, 7}
+		got := Scan(given, 10, add)
+
+		if got[len(got)-1] != Reduce(given, 10, add) {
+			t.Errorf("got %v, last element should be %d", got, Reduce(given, 10, add))
+		}
+	})
+
+	t.Run("empty slice", func(t *testing.T) {
+		got := Scan([]int{}, 0, add)
+		if got == nil || len(got) != 0 {
+			t.Errorf("got %#v want an empty slice", got)
+		}
+	})
+}
+
+func assertString(t testing.TB, got, want string) {
+	t.Helper()
+	if got != want {
+		t.Errorf("got %q want %q", got, want)
+	}
+}
@@ -0,0 +1,170 @@
+package arraysandslices
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+
+	"golang.org/x/exp/constraints"
+)
+
+var (
+	ErrSampleSize = errors.New("sample size must be between 0 and the slice length")
+	ErrEmptySlice = errors.New("slice is empty")
+)
+
+// TakeWhile returns the longest prefix of s whose elements all satisfy pred.
+// The result is a new slice, empty but never nil when no element matches.
+func TakeWhile[T any](s []T, pred func(T) bool) []T {
+	i := 0
+	for i < len(s) && pred(s[i]) {
+		i++
+	}
+	return append(make([]T, 0, i), s[:i]...)
+}
+
+// DropWhile returns what is left of s after removing the longest prefix whose
+// elements all satisfy pred. The result is a new slice, empty but never nil when
+// every element matches.
+func DropWhile[T any](s []T, pred func(T) bool) []T {
+	i := 0
+	for i < len(s) && pred(s[i]) {
+		i++
+	}
+	return append(make([]T, 0, len(s)-i), s[i:]...)
+}
+
+// Windows returns every contiguous window of size elements in s, in order, so a
+// slice of length n has n-size+1 windows. It returns an empty result when size is
+// larger than s, and also when size <= 0 since there is no meaningful window then.
+//
+// The windows share memory with s, modifying an element through a window modifies s.
+// Their capacity is capped at size so appending to a window never overwrites s.
+func Windows[T any](s []T, size int) [][]T {
+	if size <= 0 || size > len(s) {
+		return [][]T{}
+	}
+
+	windows := make([][]T, 0, len(s)-size+1)
+	for i := 0; i+size <= len(s); i++ {
+		windows = append(windows, s[i:i+size:i+size])
+	}
+	return windows
+}
+
+// Compact returns a copy of s with runs of equal consecutive elements replaced
+// by a single element, like slices.Compact but without modifying s.
+// Equal elements that are not next to each other are kept.
+func Compact[T comparable](s []T) []T {
+	return CompactFunc(s, func(a, b T) bool { return a == b })
+}
+
+// CompactFunc is Compact with a custom equality function
+func CompactFunc[T any](s []T, eq func(a, b T) bool) []T {
+	compacted := make([]T, 0, len(s))
+	for i, x := range s {
+		if i > 0 && eq(compacted[len(compacted)-1], x) {
+			continue
+		}
+		compacted = append(compacted, x)
+	}
+	return compacted
+}
+
+// InsertSorted inserts v into the already sorted s, keeping it sorted, and returns
+// the updated slice. The position is found with a binary search, v goes after any
+// elements equal to it so insertion is stable.
+// Like append, the result may share memory with s so callers should use the returned slice.
+// Time Complexity: O(log n) to find the position, O(n) to shift the elements after it
+func InsertSorted[T constraints.Ordered](s []T, v T) []T {
+	// find the first element greater than v
+	lo, hi := 0, len(s)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if s[mid] <= v {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+
+	var zero T
+	s = append(s, zero)
+	copy(s[lo+1:], s[lo:])
+	s[lo] = v
+	return s
+}
+
+// Shuffle randomly reorders s in place with the Fisher-Yates algorithm, drawing
+// from r so a seeded source gives a repeatable order. A nil r uses the global source.
+func Shuffle[T any](s []T, r *rand.Rand) {
+	intn := rand.Intn
+	if r != nil {
+		intn = r.Intn
+	}
+
+	for i := len(s) - 1; i > 0; i-- {
+		j := intn(i + 1)
+		s[i], s[j] = s[j], s[i]
+	}
+}
+
+// SampleN returns n distinct elements of s chosen uniformly at random without
+// replacement, drawing from r. s is not modified. A nil r uses the global source.
+// It runs a Fisher-Yates shuffle that stops after the first n positions.
+func SampleN[T any](s []T, n int, r *rand.Rand) ([]T, error) {
+	if n < 0 || n > len(s) {
+		return nil, fmt.Errorf("%w: got %d for a slice of length %d", ErrSampleSize, n, len(s))
+	}
+
+	intn := rand.Intn
+	if r != nil {
+		intn = r.Intn
+	}
+
+	pool := append([]T(nil), s...)
+	for i := 0; i < n; i++ {
+		j := i + intn(len(pool)-i)
+		pool[i], pool[j] = pool[j], pool[i]
+	}
+	return pool[:n:n], nil
+}
+
+// IsPermutation reports whether a and b hold the same elements the same number
+// of times, in any order. It counts the elements of a and cancels them out with b.
+func IsPermutation[T comparable](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	counts := make(map[T]int, len(a))
+	for _, v := range a {
+		counts[v]++
+	}
+	for _, v := range b {
+		if counts[v] == 0 {
+			return false
+		}
+		counts[v]--
+	}
+	return true
+}
+
+// MinMax returns the smallest and largest elements of s in a single pass,
+// or ErrEmptySlice if there are none.
+func MinMax[T constraints.Ordered](s []T) (min, max T, err error) {
+	if len(s) == 0 {
+		return min, max, ErrEmptySlice
+	}
+
+	min, max = s[0], s[0]
+	for _, v := range s[1:] {
+		if v < min {
+			min = v
+		}
+		if v > max {
+			max = v
+		}
+	}
+	return min, max, nil
+}
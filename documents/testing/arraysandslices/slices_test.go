@@ -0,0 +1,271 @@
+package arraysandslices
+
+import (
+	"errors"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestTakeWhileDropWhile(t *testing.T) {
+	isEven := func(i int) bool { return i%2 == 0 }
+
+	cases := []struct {
+		name     string
+		given    []int
+		wantTake []int
+		wantDrop []int
+	}{
+		{"matching prefix", []int{2, 4, 5, 6, 8}, []int{2, 4}, []int{5, 6, 8}},
+		{"no matching prefix", []int{1, 2, 4}, []int{}, []int{1, 2, 4}},
+		{"all matching", []int{2, 4, 6}, []int{2, 4, 6}, []int{}},
+		{"empty", []int{}, []int{}, []int{}},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			take := TakeWhile(tt.given, isEven)
+			drop := DropWhile(tt.given, isEven)
+
+			assertInts(t, take, tt.wantTake)
+			assertInts(t, drop, tt.wantDrop)
+		})
+	}
+}
+
+// assertInts also checks for nil so functions promising an empty slice keep that promise
+func assertInts(t testing.TB, got, want []int) {
+	t.Helper()
+	if got == nil {
+		t.Fatalf("got nil, want %v", want)
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %v want %v", got, want)
+	}
+}
+
+func TestWindows(t *testing.T) {
+	given := []int{1, 2, 3, 4}
+
+	cases := []struct {
+		name string
+		size int
+		want [][]int
+	}{
+		{"size two", 2, [][]int{{1, 2}, {2, 3}, {3, 4}}},
+		{"size equal to length", 4, [][]int{{1, 2, 3, 4}}},
+		{"size larger than slice", 5, [][]int{}},
+		{"size zero", 0, [][]int{}},
+		{"negative size", -1, [][]int{}},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Windows(given, tt.size)
+
+			if !slices.EqualFunc(got, tt.want, slices.Equal[[]int]) {
+				t.Errorf("got %v want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("appending to a window leaves the input alone", func(t *testing.T) {
+		s := []int{1, 2, 3}
+		windows := Windows(s, 2)
+		_ = append(windows[0], 99)
+
+		assertInts(t, s, []int{1, 2, 3})
+	})
+}
+
+func TestCompact(t *testing.T) {
+	cases := []struct {
+		name  string
+		given []int
+		want  []int
+	}{
+		{"runs of duplicates", []int{1, 1, 1, 2, 2, 3, 1, 1}, []int{1, 2, 3, 1}},
+		{"no duplicates", []int{1, 2, 3}, []int{1, 2, 3}},
+		{"non adjacent duplicates are kept", []int{1, 2, 1, 2}, []int{1, 2, 1, 2}},
+		{"empty", []int{}, []int{}},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			given := slices.Clone(tt.given)
+
+			got := Compact(given)
+
+			assertInts(t, got, tt.want)
+			assertInts(t, given, tt.given)
+		})
+	}
+
+	t.Run("custom equality", func(t *testing.T) {
+		given := []string{"Go", "go", "GO", "rust", "Go"}
+		got := CompactFunc(given, strings.EqualFold)
+		want := []string{"Go", "rust", "Go"}
+
+		if !slices.Equal(got, want) {
+			t.Errorf("got %v want %v", got, want)
+		}
+	})
+}
+
+func TestInsertSorted(t *testing.T) {
+	cases := []struct {
+		name  string
+		given []int
+		v     int
+		want  []int
+	}{
+		{"front", []int{2, 3, 4}, 1, []int{1, 2, 3, 4}},
+		{"middle", []int{1, 3, 5}, 4, []int{1, 3, 4, 5}},
+		{"end", []int{1, 2, 3}, 9, []int{1, 2, 3, 9}},
+		{"empty slice", []int{}, 5, []int{5}},
+		{"duplicate", []int{1, 2, 2, 3}, 2, []int{1, 2, 2, 2, 3}},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			got := InsertSorted(slices.Clone(tt.given), tt.v)
+			assertInts(t, got, tt.want)
+		})
+	}
+
+	t.Run("strings", func(t *testing.T) {
+		got := InsertSorted([]string{"apple", "cherry"}, "banana")
+		want := []string{"apple", "banana", "cherry"}
+
+		if !slices.Equal(got, want) {
+			t.Errorf("got %v want %v", got, want)
+		}
+	})
+}
+
+func TestShuffle(t *testing.T) {
+	t.Run("seeded source gives a known permutation", func(t *testing.T) {
+		given := []int{1, 2, 3, 4, 5, 6, 7, 8}
+		Shuffle(given, rand.New(rand.NewSource(42)))
+
+		want := []int{5, 6, 8, 4, 1, 7, 3, 2}
+		assertInts(t, given, want)
+	})
+
+	t.Run("elements are preserved", func(t *testing.T) {
+		given := []string{"a", "b", "b", "c", "d", "d", "d"}
+		shuffled := slices.Clone(given)
+		Shuffle(shuffled, rand.New(rand.NewSource(7)))
+
+		slices.Sort(shuffled)
+		if !slices.Equal(shuffled, given) {
+			t.Errorf("got %v, want the same elements as %v", shuffled, given)
+		}
+	})
+
+	t.Run("nil source uses a default", func(t *testing.T) {
+		given := []int{1, 2, 3}
+		Shuffle(given, nil)
+
+		slices.Sort(given)
+		assertInts(t, given, []int{1, 2, 3})
+	})
+
+	t.Run("empty slice", func(t *testing.T) {
+		Shuffle([]int{}, rand.New(rand.NewSource(1)))
+	})
+}
+
+func TestSampleN(t *testing.T) {
+	t.Run("seeded source gives a known sample", func(t *testing.T) {
+		given := []int{1, 2, 3, 4, 5, 6, 7, 8}
+
+		got, err := SampleN(given, 3, rand.New(rand.NewSource(42)))
+		if err != nil {
+			t.Fatalf("did not expect an error but got one %v", err)
+		}
+
+		assertInts(t, got, []int{2, 4, 5})
+		assertInts(t, given, []int{1, 2, 3, 4, 5, 6, 7, 8})
+	})
+
+	t.Run("sampling every element gives a permutation", func(t *testing.T) {
+		given := []int{1, 2, 3, 4, 5}
+
+		got, err := SampleN(given, len(given), rand.New(rand.NewSource(3)))
+		if err != nil {
+			t.Fatalf("did not expect an error but got one %v", err)
+		}
+
+		slices.Sort(got)
+		assertInts(t, got, given)
+	})
+
+	t.Run("zero elements", func(t *testing.T) {
+		got, err := SampleN([]int{1, 2}, 0, nil)
+		if err != nil {
+			t.Fatalf("did not expect an error but got one %v", err)
+		}
+		assertInts(t, got, []int{})
+	})
+
+	t.Run("more elements than the slice has", func(t *testing.T) {
+		_, err := SampleN([]int{1, 2}, 3, nil)
+		if !errors.Is(err, ErrSampleSize) {
+			t.Errorf("got error %v want %v", err, ErrSampleSize)
+		}
+	})
+}
+
+func TestIsPermutation(t *testing.T) {
+	cases := []struct {
+		name string
+		a, b []int
+		want bool
+	}{
+		{"same elements in a different order", []int{3, 1, 2, 1}, []int{1, 1, 3, 2}, true},
+		{"identical slices", []int{1, 2, 3}, []int{1, 2, 3}, true},
+		{"different counts of an element", []int{1, 1, 2}, []int{1, 2, 2}, false},
+		{"different lengths", []int{1, 2}, []int{1, 2, 2}, false},
+		{"different elements", []int{1, 2, 3}, []int{1, 2, 4}, false},
+		{"both empty", []int{}, nil, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := IsPermutation(c.a, c.b); got != c.want {
+				t.Errorf("IsPermutation(%v, %v) = %t want %t", c.a, c.b, got, c.want)
+			}
+		})
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	t.Run("mixed slice", func(t *testing.T) {
+		min, max, err := MinMax([]int{3, -7, 12, 0, 5})
+		if err != nil {
+			t.Fatalf("did not expect an error but got one %v", err)
+		}
+		if min != -7 || max != 12 {
+			t.Errorf("got min %d max %d want -7 12", min, max)
+		}
+	})
+
+	t.Run("single element", func(t *testing.T) {
+		min, max, err := MinMax([]string{"only"})
+		if err != nil {
+			t.Fatalf("did not expect an error but got one %v", err)
+		}
+		if min != "only" || max != "only" {
+			t.Errorf("got min %q max %q want both %q", min, max, "only")
+		}
+	})
+
+	t.Run("empty slice", func(t *testing.T) {
+		_, _, err := MinMax([]float64{})
+		if !errors.Is(err, ErrEmptySlice) {
+			t.Errorf("got error %v want %v", err, ErrEmptySlice)
+		}
+	})
+}
@@ -1,5 +1,15 @@
 package arraysandslices
 
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+var ErrInvalidNumber = errors.New("invalid number")
+
 func Sum(a []int) int {
 	sum := 0
 	for _, i := range a {
@@ -8,10 +18,17 @@ func Sum(a []int) int {
 	return sum
 }
 
+// SumAll returns the sum of each slice passed in. The result is allocated once
+// up front since its length is known, rather than grown with append.
 func SumAll(numbersToSum ...[]int) []int {
-	var sums []int
-	for _, numbers := range numbersToSum {
-		sums = append(sums, Sum(numbers))
+	if len(numbersToSum) == 0 {
+		// match the old append based version, which returned nil for no input
+		return nil
+	}
+
+	sums := make([]int, len(numbersToSum))
+	for i, numbers := range numbersToSum {
+		sums[i] = Sum(numbers)
 	}
 
 	return sums
@@ -28,4 +45,27 @@ func SumAllTails(tailsToSum ...[]int) []int {
 		}
 	}
 	return sums
-}
\ No newline at end of file
+}
+
+// SumReader adds up the whitespace separated integers read from r, reading one
+// token at a time so the input never has to fit in memory. It stops at the first
+// token that isn't an integer and returns an error naming it.
+func SumReader(r io.Reader) (int, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Split(bufio.ScanWords)
+
+	sum := 0
+	for scanner.Scan() {
+		token := scanner.Text()
+		n, err := strconv.Atoi(token)
+		if err != nil {
+			return 0, fmt.Errorf("%w: %q", ErrInvalidNumber, token)
+		}
+		sum += n
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+
+	return sum, nil
+}
@@ -1,8 +1,12 @@
 package arraysandslices
 
 import (
+	"errors"
 	"fmt"
+	"math/rand"
+	"reflect"
 	"slices"
+	"strings"
 	"testing"
 )
 
@@ -26,6 +30,69 @@ func BenchmarkRepeat(b *testing.B) {
 	}
 }
 
+// benchmarkSizes are the input lengths used by the parameterized benchmarks
+var benchmarkSizes = []int{1_000, 1_000_000}
+
+// generateNumbers returns n pseudo random numbers from a fixed seed so every
+// benchmark run sees the same input
+func generateNumbers(n int) []int {
+	r := rand.New(rand.NewSource(1))
+	numbers := make([]int, n)
+	for i := range numbers {
+		numbers[i] = r.Intn(1000)
+	}
+	return numbers
+}
+
+func BenchmarkSum(b *testing.B) {
+	for _, size := range benchmarkSizes {
+		b.Run(fmt.Sprintf("size=%d", size), func(b *testing.B) {
+			given := generateNumbers(size)
+
+			for b.Loop() {
+				Sum(given)
+			}
+		})
+	}
+}
+
+func BenchmarkSumAll(b *testing.B) {
+	for _, size := range benchmarkSizes {
+		b.Run(fmt.Sprintf("size=%d", size), func(b *testing.B) {
+			given := generateNumbers(size)
+			// split the input into ten slices to sum
+			chunk := size / 10
+			inputs := make([][]int, 0, 10)
+			for i := 0; i < size; i += chunk {
+				inputs = append(inputs, given[i:i+chunk])
+			}
+
+			for b.Loop() {
+				SumAll(inputs...)
+			}
+		})
+	}
+}
+
+// BenchmarkSumAllManySlices sums lots of short slices, where growing the result
+// with append used to reallocate several times per call. Run with -benchmem.
+func BenchmarkSumAllManySlices(b *testing.B) {
+	for _, count := range []int{10, 1_000} {
+		b.Run(fmt.Sprintf("slices=%d", count), func(b *testing.B) {
+			given := generateNumbers(count * 5)
+			inputs := make([][]int, count)
+			for i := range inputs {
+				inputs[i] = given[i*5 : i*5+5]
+			}
+
+			b.ReportAllocs()
+			for b.Loop() {
+				SumAll(inputs...)
+			}
+		})
+	}
+}
+
 func ExampleSum() {
 	given := []int{1, 2, 3, 4, 5}
 	fmt.Printf("%d", Sum(given))
@@ -41,6 +108,35 @@ func TestSumAll(t *testing.T) {
 	}
 }
 
+// sumAllAppend is the original append based SumAll, kept to check the
+// preallocating version returns exactly the same results
+func sumAllAppend(numbersToSum ...[]int) []int {
+	var sums []int
+	for _, numbers := range numbersToSum {
+		sums = append(sums, Sum(numbers))
+	}
+	return sums
+}
+
+func TestSumAllMatchesAppend(t *testing.T) {
+	inputs := [][][]int{
+		nil,
+		{{}},
+		{{1, 2}, {0, 9}},
+		{{-1, 1}, {}, {5}, {100, 200, 300}},
+		{generateNumbers(50), generateNumbers(7)},
+	}
+
+	for _, input := range inputs {
+		got := SumAll(input...)
+		want := sumAllAppend(input...)
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("SumAll(%v) = %#v want %#v", input, got, want)
+		}
+	}
+}
+
 func TestSumAllTails(t *testing.T) {
 	checkSums := func(t testing.TB, got, want []int) {
 		t.Helper()
@@ -61,3 +157,35 @@ func TestSumAllTails(t *testing.T) {
 		checkSums(t, got, want)
 	})
 }
+
+func TestSumReader(t *testing.T) {
+	t.Run("numbers across lines and spaces", func(t *testing.T) {
+		got, err := SumReader(strings.NewReader("1 2  3\n-4\t10\n"))
+		if err != nil {
+			t.Fatalf("did not expect an error but got one %v", err)
+		}
+		if got != 12 {
+			t.Errorf("got %d want 12", got)
+		}
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		got, err := SumReader(strings.NewReader(""))
+		if err != nil {
+			t.Fatalf("did not expect an error but got one %v", err)
+		}
+		if got != 0 {
+			t.Errorf("got %d want 0", got)
+		}
+	})
+
+	t.Run("malformed token", func(t *testing.T) {
+		_, err := SumReader(strings.NewReader("1 2 three 4"))
+		if !errors.Is(err, ErrInvalidNumber) {
+			t.Fatalf("got error %v want %v", err, ErrInvalidNumber)
+		}
+		if !strings.Contains(err.Error(), "three") {
+			t.Errorf("expected the error %q to name the token", err)
+		}
+	})
+}
@@ -69,6 +69,10 @@ func TestNewBlogPosts_ErrorHandling(t *testing.T) {
 	}
 }
 
+func fstestFS(name, body string) fstest.MapFS {
+	return fstest.MapFS{name: {Data: []byte(body)}}
+}
+
 type StubFailingFS struct{}
 
 func (s StubFailingFS) Open(name string) (fs.File, error) {
@@ -6,17 +6,39 @@ import (
 	"fmt"
 	"io"
 	"io/fs"
+	"strconv"
 	"strings"
+	"time"
 )
 
 type Post struct {
 	Title       string
 	Description string
 	Tags        []string
+	Date        time.Time
+	Draft       bool
 	Body        string
 }
 
+// ParseConfig controls how post files are parsed
+type ParseConfig struct {
+	// Delimiter is the line that ends the front matter and starts the body.
+	// It must be on a line of its own. Empty means the default, "---".
+	Delimiter string
+}
+
 func NewPostFromFS(fileSystem fs.FS) ([]Post, error) {
+	return NewPostFromFSWithConfig(fileSystem, ParseConfig{})
+}
+
+// NewPostFromFSWithConfig is NewPostFromFS with control over parsing, for
+// example to read posts whose front matter ends with "+++" instead of "---"
+func NewPostFromFSWithConfig(fileSystem fs.FS, config ParseConfig) ([]Post, error) {
+	delimiter := config.Delimiter
+	if delimiter == "" {
+		delimiter = bodySeparator
+	}
+
 	dir, err := fs.ReadDir(fileSystem, ".")
 	if err != nil {
 		return nil, err
@@ -24,7 +46,7 @@ func NewPostFromFS(fileSystem fs.FS) ([]Post, error) {
 
 	var posts []Post
 	for _, f := range dir {
-		post, err := getPost(fileSystem, f.Name())
+		post, err := getPost(fileSystem, f.Name(), delimiter)
 		if err != nil {
 			return nil, err
 		}
@@ -34,43 +56,94 @@ func NewPostFromFS(fileSystem fs.FS) ([]Post, error) {
 	return posts, nil
 }
 
-func getPost(fileSystem fs.FS, fileName string) (Post, error) {
+func getPost(fileSystem fs.FS, fileName, delimiter string) (Post, error) {
 	postFile, err := fileSystem.Open(fileName)
 	if err != nil {
 		return Post{}, err
 	}
 	defer postFile.Close()
 
-	return newPost(postFile)
+	return newPost(postFile, delimiter)
 }
 
 const (
 	titleSeparator       = "Title: "
 	descriptionSeparator = "Description: "
 	tagsSeparator        = "Tags: "
+	dateSeparator        = "Date: "
+	draftSeparator       = "Draft: "
+	bodySeparator        = "---"
+
+	dateLayout = "2006-01-02"
 )
 
-func newPost(postBody io.Reader) (Post, error) {
+// newPost reads the meta lines up to the delimiter line and then the body.
+// Title, Description and Tags are expected on every post, Date and Draft are optional.
+func newPost(postBody io.Reader, delimiter string) (Post, error) {
 	scanner := bufio.NewScanner(postBody)
 
-	readMetaLine := func(tagName string) string {
-		scanner.Scan()
-		return strings.TrimPrefix(scanner.Text(), tagName)
+	var post Post
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == delimiter {
+			break
+		}
+
+		if value, ok := strings.CutPrefix(line, titleSeparator); ok {
+			post.Title = value
+		} else if value, ok := strings.CutPrefix(line, descriptionSeparator); ok {
+			post.Description = value
+		} else if value, ok := strings.CutPrefix(line, tagsSeparator); ok {
+			post.Tags = strings.Split(value, ", ")
+		} else if value, ok := strings.CutPrefix(line, dateSeparator); ok {
+			date, err := time.Parse(dateLayout, value)
+			if err != nil {
+				return Post{}, fmt.Errorf("parsing date of post %q: %w", post.Title, err)
+			}
+			post.Date = date
+		} else if value, ok := strings.CutPrefix(line, draftSeparator); ok {
+			draft, err := strconv.ParseBool(value)
+			if err != nil {
+				return Post{}, fmt.Errorf("parsing draft flag of post %q: %w", post.Title, err)
+			}
+			post.Draft = draft
+		}
 	}
 
-	return Post{
-		Title:       readMetaLine(titleSeparator),
-		Description: readMetaLine(descriptionSeparator),
-		Tags:        strings.Split(readMetaLine(tagsSeparator), ", "),
-		Body:        readBody(scanner),
-	}, nil
+	post.Body = readBody(scanner)
+	return post, nil
 }
 
 func readBody(scanner *bufio.Scanner) string {
-	scanner.Scan() // skip the --- line
 	buf := bytes.Buffer{}
 	for scanner.Scan() {
 		fmt.Fprintln(&buf, scanner.Text())
 	}
 	return strings.TrimSuffix(buf.String(), "\n")
-}
\ No newline at end of file
+}
+
+// Paragraphs splits the body into paragraphs separated by blank lines, where a
+// line holding only whitespace counts as blank. Each paragraph is trimmed and
+// keeps its inner line breaks, empty paragraphs are dropped.
+func (p Post) Paragraphs() []string {
+	var paragraphs []string
+	var current []string
+
+	flush := func() {
+		if text := strings.TrimSpace(strings.Join(current, "\n")); text != "" {
+			paragraphs = append(paragraphs, text)
+		}
+		current = current[:0]
+	}
+
+	for _, line := range strings.Split(p.Body, "\n") {
+		if strings.TrimSpace(line) == "" {
+			flush()
+			continue
+		}
+		current = append(current, line)
+	}
+	flush()
+
+	return paragraphs
+}
@@ -0,0 +1,66 @@
+package blogposts_test
+
+import (
+	"reflect"
+	"testing"
+
+	blogposts "github.com/aziz-shoko/dsa-go/blogposts"
+)
+
+func TestNewPostFromFSWithConfig(t *testing.T) {
+	t.Run("toml style delimiter", func(t *testing.T) {
+		body := `Title: Post 1
+Description: Description 1
+Tags: tdd, go
++++
+Hello
+---
+World`
+		posts, err := blogposts.NewPostFromFSWithConfig(fstestFS("toml.md", body), blogposts.ParseConfig{Delimiter: "+++"})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := blogposts.Post{
+			Title:       "Post 1",
+			Description: "Description 1",
+			Tags:        []string{"tdd", "go"},
+			// only the configured delimiter ends the front matter, a --- in the body is content
+			Body: "Hello\n---\nWorld",
+		}
+		if !reflect.DeepEqual(posts[0], want) {
+			t.Errorf("got %+v want %+v", posts[0], want)
+		}
+	})
+
+	t.Run("delimiter must be on its own line", func(t *testing.T) {
+		body := `Title: Post 1
+Description: ends with +++ but is not a delimiter
++++
+Hello`
+		posts, err := blogposts.NewPostFromFSWithConfig(fstestFS("toml.md", body), blogposts.ParseConfig{Delimiter: "+++"})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if posts[0].Description != "ends with +++ but is not a delimiter" || posts[0].Body != "Hello" {
+			t.Errorf("got %+v", posts[0])
+		}
+	})
+
+	t.Run("empty config defaults to ---", func(t *testing.T) {
+		body := `Title: Post 1
+Description: Description 1
+Tags: go
+---
+Hello`
+		posts, err := blogposts.NewPostFromFSWithConfig(fstestFS("yaml.md", body), blogposts.ParseConfig{})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if posts[0].Title != "Post 1" || posts[0].Body != "Hello" {
+			t.Errorf("got %+v", posts[0])
+		}
+	})
+}
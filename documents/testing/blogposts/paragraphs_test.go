@@ -0,0 +1,51 @@
+package blogposts_test
+
+import (
+	"reflect"
+	"testing"
+
+	blogposts "github.com/aziz-shoko/dsa-go/blogposts"
+)
+
+func TestParagraphs(t *testing.T) {
+	cases := []struct {
+		name string
+		body string
+		want []string
+	}{
+		{
+			name: "lines without a blank line are one paragraph",
+			body: "Hello\nWorld",
+			want: []string{"Hello\nWorld"},
+		},
+		{
+			name: "blank lines separate paragraphs",
+			body: "First paragraph\ncontinues here\n\nSecond paragraph\n\n\nThird",
+			want: []string{"First paragraph\ncontinues here", "Second paragraph", "Third"},
+		},
+		{
+			name: "leading and trailing blank lines are dropped",
+			body: "\n\n  \nOnly one\n\n\t\n",
+			want: []string{"Only one"},
+		},
+		{
+			name: "paragraphs are trimmed",
+			body: "  padded  \n\n\tindented",
+			want: []string{"padded", "indented"},
+		},
+		{
+			name: "empty body",
+			body: "",
+			want: nil,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := blogposts.Post{Body: c.body}.Paragraphs()
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("got %q want %q", got, c.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package blogposts
+
+import (
+	"slices"
+)
+
+// PostQuery is an immutable, chainable filter over a set of posts.
+// Every method returns a new PostQuery and leaves the receiver untouched, so a
+// partially built query can be reused as the base for several others.
+type PostQuery struct {
+	posts []Post
+}
+
+// Query starts a query over posts
+func Query(posts []Post) PostQuery {
+	return PostQuery{posts: slices.Clone(posts)}
+}
+
+// WithTag keeps the posts tagged with tag, ignoring case
+func (q PostQuery) WithTag(tag string) PostQuery {
+	return q.filter(func(p Post) bool {
+		return p.hasTag(tag)
+	})
+}
+
+// Published keeps the posts that are not drafts
+func (q PostQuery) Published() PostQuery {
+	return q.filter(func(p Post) bool {
+		return !p.Draft
+	})
+}
+
+// SortByDate orders the posts by date, newest first when desc is true.
+// Posts with the same date keep their relative order.
+func (q PostQuery) SortByDate(desc bool) PostQuery {
+	sorted := slices.Clone(q.posts)
+	slices.SortStableFunc(sorted, func(a, b Post) int {
+		if desc {
+			return b.Date.Compare(a.Date)
+		}
+		return a.Date.Compare(b.Date)
+	})
+	return PostQuery{posts: sorted}
+}
+
+// Limit keeps at most the first n posts
+func (q PostQuery) Limit(n int) PostQuery {
+	n = max(0, min(n, len(q.posts)))
+	return PostQuery{posts: slices.Clone(q.posts[:n])}
+}
+
+// Result returns the posts matching the query
+func (q PostQuery) Result() []Post {
+	return slices.Clone(q.posts)
+}
+
+func (q PostQuery) filter(keep func(Post) bool) PostQuery {
+	return PostQuery{posts: filterPosts(q.posts, keep)}
+}
@@ -0,0 +1,102 @@
+package blogposts_test
+
+import (
+	"testing"
+	"time"
+
+	blogposts "github.com/aziz-shoko/dsa-go/blogposts"
+)
+
+func date(s string) time.Time {
+	d, _ := time.Parse("2006-01-02", s)
+	return d
+}
+
+var corpus = []blogposts.Post{
+	{Title: "Go generics", Tags: []string{"go", "generics"}, Date: date("2024-03-01")},
+	{Title: "Rust lifetimes", Tags: []string{"rust"}, Date: date("2024-05-01")},
+	{Title: "Go testing", Tags: []string{"Go", "tdd"}, Date: date("2024-06-01")},
+	{Title: "Go draft", Tags: []string{"go"}, Date: date("2024-07-01"), Draft: true},
+	{Title: "Go modules", Tags: []string{"go"}, Date: date("2024-01-01")},
+}
+
+func TestQuery(t *testing.T) {
+	t.Run("tag filter, date sort and limit compose", func(t *testing.T) {
+		got := blogposts.Query(corpus).
+			WithTag("go").
+			Published().
+			SortByDate(true).
+			Limit(2).
+			Result()
+
+		assertTitles(t, got, "Go testing", "Go generics")
+	})
+
+	t.Run("ascending sort", func(t *testing.T) {
+		got := blogposts.Query(corpus).WithTag("GO").SortByDate(false).Result()
+
+		assertTitles(t, got, "Go modules", "Go generics", "Go testing", "Go draft")
+	})
+
+	t.Run("queries are immutable", func(t *testing.T) {
+		base := blogposts.Query(corpus).WithTag("go")
+		limited := base.Limit(1)
+
+		assertTitles(t, limited.Result(), "Go generics")
+		assertTitles(t, base.Result(), "Go generics", "Go testing", "Go draft", "Go modules")
+	})
+
+	t.Run("limit larger than the result keeps everything", func(t *testing.T) {
+		got := blogposts.Query(corpus).WithTag("rust").Limit(10).Result()
+
+		assertTitles(t, got, "Rust lifetimes")
+	})
+}
+
+func TestNewBlogPostsWithDateAndDraft(t *testing.T) {
+	const body = `Title: Post 1
+Description: Description 1
+Tags: tdd, go
+Date: 2024-06-01
+Draft: true
+---
+Hello`
+
+	posts, err := blogposts.NewPostFromFS(fstestFS("post.md", body))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertPost(t, posts[0], blogposts.Post{
+		Title:       "Post 1",
+		Description: "Description 1",
+		Tags:        []string{"tdd", "go"},
+		Date:        date("2024-06-01"),
+		Draft:       true,
+		Body:        "Hello",
+	})
+}
+
+func TestNewBlogPostsWithBadDate(t *testing.T) {
+	const body = `Title: Post 1
+Date: yesterday
+---
+Hello`
+
+	_, err := blogposts.NewPostFromFS(fstestFS("post.md", body))
+	if err == nil {
+		t.Error("expected an error but didn't get one")
+	}
+}
+
+func assertTitles(t *testing.T, posts []blogposts.Post, want ...string) {
+	t.Helper()
+	if len(posts) != len(want) {
+		t.Fatalf("got %d posts, wanted %d", len(posts), len(want))
+	}
+	for i, p := range posts {
+		if p.Title != want[i] {
+			t.Errorf("post %d got title %q, wanted %q", i, p.Title, want[i])
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package blogposts
+
+import (
+	"slices"
+	"strings"
+)
+
+// FilterByTagsAll returns the posts tagged with every one of tags, ignoring case.
+// With no tags every post is returned. Like FilterByTagsAny, it returns nil when
+// nothing is left.
+func FilterByTagsAll(posts []Post, tags ...string) []Post {
+	return filterPosts(posts, func(p Post) bool {
+		for _, tag := range tags {
+			if !p.hasTag(tag) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// FilterByTagsAny returns the posts tagged with at least one of tags, ignoring case.
+// With no tags every post is returned. Like FilterByTagsAll, it returns nil when
+// nothing is left.
+func FilterByTagsAny(posts []Post, tags ...string) []Post {
+	return filterPosts(posts, func(p Post) bool {
+		return len(tags) == 0 || slices.ContainsFunc(tags, p.hasTag)
+	})
+}
+
+// hasTag reports whether p is tagged with tag, ignoring case
+func (p Post) hasTag(tag string) bool {
+	return slices.ContainsFunc(p.Tags, func(t string) bool {
+		return strings.EqualFold(t, tag)
+	})
+}
+
+func filterPosts(posts []Post, keep func(Post) bool) []Post {
+	var matching []Post
+	for _, p := range posts {
+		if keep(p) {
+			matching = append(matching, p)
+		}
+	}
+	return matching
+}
@@ -0,0 +1,55 @@
+package blogposts_test
+
+import (
+	"testing"
+
+	blogposts "github.com/aziz-shoko/dsa-go/blogposts"
+)
+
+func TestFilterByTags(t *testing.T) {
+	t.Run("all requires every tag", func(t *testing.T) {
+		got := blogposts.FilterByTagsAll(corpus, "go", "TDD")
+		assertTitles(t, got, "Go testing")
+	})
+
+	t.Run("all with a single tag", func(t *testing.T) {
+		got := blogposts.FilterByTagsAll(corpus, "generics")
+		assertTitles(t, got, "Go generics")
+	})
+
+	t.Run("all with no match", func(t *testing.T) {
+		got := blogposts.FilterByTagsAll(corpus, "go", "rust")
+		assertTitles(t, got)
+	})
+
+	t.Run("any requires at least one tag", func(t *testing.T) {
+		got := blogposts.FilterByTagsAny(corpus, "RUST", "tdd", "generics")
+		assertTitles(t, got, "Go generics", "Rust lifetimes", "Go testing")
+	})
+
+	t.Run("any with no match", func(t *testing.T) {
+		got := blogposts.FilterByTagsAny(corpus, "python")
+		assertTitles(t, got)
+	})
+
+	t.Run("no tags returns every post", func(t *testing.T) {
+		titles := make([]string, len(corpus))
+		for i, p := range corpus {
+			titles[i] = p.Title
+		}
+
+		assertTitles(t, blogposts.FilterByTagsAll(corpus), titles...)
+		assertTitles(t, blogposts.FilterByTagsAny(corpus), titles...)
+	})
+
+	t.Run("empty input gives the same result shape", func(t *testing.T) {
+		empty := []blogposts.Post{}
+
+		if got := blogposts.FilterByTagsAll(empty); got != nil {
+			t.Errorf("FilterByTagsAll got %#v want nil", got)
+		}
+		if got := blogposts.FilterByTagsAny(empty); got != nil {
+			t.Errorf("FilterByTagsAny got %#v want nil", got)
+		}
+	})
+}
@@ -0,0 +1,30 @@
+package context
+
+import (
+	"context"
+
+	counter "github.com/aziz-shoko/dsa-go/documents/testing/sync"
+)
+
+type metricsStore struct {
+	store  Store
+	hits   *counter.Counter
+	errors *counter.Counter
+}
+
+// WithMetrics decorates store so every successful Fetch increments hits and
+// every failed Fetch increments errors. The returned Store is still a Store, so
+// it can be passed straight to Server.
+func WithMetrics(store Store, hits, errors *counter.Counter) Store {
+	return &metricsStore{store: store, hits: hits, errors: errors}
+}
+
+func (m *metricsStore) Fetch(ctx context.Context) (string, error) {
+	data, err := m.store.Fetch(ctx)
+	if err != nil {
+		m.errors.Inc()
+		return "", err
+	}
+	m.hits.Inc()
+	return data, nil
+}
@@ -0,0 +1,65 @@
+package context
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	counter "github.com/aziz-shoko/dsa-go/documents/testing/sync"
+)
+
+// StubStore returns its responses in order, one per Fetch
+type StubStore struct {
+	mu        sync.Mutex
+	responses []error
+}
+
+func (s *StubStore) Fetch(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	err := s.responses[0]
+	s.responses = s.responses[1:]
+	if err != nil {
+		return "", err
+	}
+	return "data", nil
+}
+
+func TestWithMetrics(t *testing.T) {
+	t.Run("counts a hit and an error", func(t *testing.T) {
+		stub := &StubStore{responses: []error{nil, errors.New("store is down")}}
+		hits, errs := counter.NewCounter(), counter.NewCounter()
+		store := WithMetrics(stub, hits, errs)
+
+		var wg sync.WaitGroup
+		wg.Add(2)
+		for i := 0; i < 2; i++ {
+			go func() {
+				defer wg.Done()
+				store.Fetch(context.Background())
+			}()
+		}
+		wg.Wait()
+
+		if hits.Value() != 1 {
+			t.Errorf("got %d hits, want %d", hits.Value(), 1)
+		}
+		if errs.Value() != 1 {
+			t.Errorf("got %d errors, want %d", errs.Value(), 1)
+		}
+	})
+
+	t.Run("passes the data through", func(t *testing.T) {
+		store := WithMetrics(&StubStore{responses: []error{nil}}, counter.NewCounter(), counter.NewCounter())
+
+		got, err := store.Fetch(context.Background())
+		if err != nil {
+			t.Fatalf("did not expect an error but got one %v", err)
+		}
+		if got != "data" {
+			t.Errorf(`got "%s", want "%s"`, got, "data")
+		}
+	})
+}
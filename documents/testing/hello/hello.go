@@ -2,6 +2,8 @@ package main
 
 import (
 	"fmt"
+
+	"github.com/aziz-shoko/dsa-go/helpers/registry"
 )
 
 const spanish string = "Spanish"
@@ -10,17 +12,30 @@ const englishHelloPrefix string = "Hello, "
 const spanishHelloPrefix string = "Hola, "
 const frenchHelloPrefix string = "Bonjour, "
 
+// greetings holds the hello prefix for every language other than English, the default
+var greetings = newGreetings()
+
+func newGreetings() *registry.Registry[string] {
+	r := registry.New[string]()
+	for language, prefix := range map[string]string{
+		spanish: spanishHelloPrefix,
+		french:  frenchHelloPrefix,
+	} {
+		if err := r.Register(language, prefix); err != nil {
+			panic(err)
+		}
+	}
+	return r
+}
+
 func Hello(name, language string) string {
 	if name == "" {
 		name = "World"
 	}
 
-	prefix := englishHelloPrefix
-	switch language {
-	case spanish:
-		prefix = spanishHelloPrefix
-	case french:
-		prefix = frenchHelloPrefix
+	prefix, ok := greetings.Get(language)
+	if !ok {
+		prefix = englishHelloPrefix
 	}
 
 	return prefix + name
@@ -1,5 +1,27 @@
 package integer
 
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+var ErrOverflow = errors.New("integer overflow")
+
 func Add(a, b int) int {
 	return a + b
-}
\ No newline at end of file
+}
+
+// SumChecked adds nums in order and returns ErrOverflow, wrapped with the index
+// of the number that pushed the running total out of range, instead of silently
+// wrapping around. Summing no numbers gives 0.
+func SumChecked(nums ...int) (int, error) {
+	sum := 0
+	for i, n := range nums {
+		if (n > 0 && sum > math.MaxInt-n) || (n < 0 && sum < math.MinInt-n) {
+			return 0, fmt.Errorf("%w at index %d", ErrOverflow, i)
+		}
+		sum += n
+	}
+	return sum, nil
+}
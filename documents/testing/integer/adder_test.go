@@ -1,8 +1,11 @@
 package integer
 
 import (
-	"testing"
+	"errors"
 	"fmt"
+	"math"
+	"strings"
+	"testing"
 )
 
 func TestAddr(t *testing.T) {
@@ -20,3 +23,55 @@ func ExampleAdd() {
 	// Output: 6
 }
 
+func TestSumChecked(t *testing.T) {
+	t.Run("clean sum", func(t *testing.T) {
+		sum, err := SumChecked(1, 2, 3, -4)
+		if err != nil {
+			t.Fatalf("did not expect an error but got one %v", err)
+		}
+		if sum != 2 {
+			t.Errorf("Expected 2 but got %d", sum)
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		sum, err := SumChecked()
+		if err != nil {
+			t.Fatalf("did not expect an error but got one %v", err)
+		}
+		if sum != 0 {
+			t.Errorf("Expected 0 but got %d", sum)
+		}
+	})
+
+	t.Run("sums right up to the limit", func(t *testing.T) {
+		sum, err := SumChecked(math.MaxInt-1, 1, math.MinInt, -1)
+		if err != nil {
+			t.Fatalf("did not expect an error but got one %v", err)
+		}
+		if sum != -2 {
+			t.Errorf("Expected -2 but got %d", sum)
+		}
+	})
+
+	overflows := []struct {
+		name  string
+		nums  []int
+		index string
+	}{
+		{"positive overflow mid sequence", []int{1, math.MaxInt - 1, 1, 5}, "index 2"},
+		{"negative overflow", []int{math.MinInt + 1, -2}, "index 1"},
+	}
+
+	for _, tt := range overflows {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := SumChecked(tt.nums...)
+			if !errors.Is(err, ErrOverflow) {
+				t.Fatalf("Expected %v but got %v", ErrOverflow, err)
+			}
+			if !strings.Contains(err.Error(), tt.index) {
+				t.Errorf("Expected %q to report %s", err, tt.index)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package maps
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aziz-shoko/dsa-go/helpers/maputil"
+)
+
+// AddAll adds every entry that isn't already in the dictionary. Unlike calling
+// Add in a loop it doesn't stop at the first conflict: words that already exist
+// are left untouched and reported together in a single error, which matches
+// ErrWordExists with errors.Is. Words are added in sorted order so the error
+// lists them the same way every time.
+func (d Dictionary) AddAll(entries map[string]string) error {
+	var errs []error
+	for _, word := range maputil.SortedKeys(entries) {
+		if err := d.Add(word, entries[word]); err != nil {
+			errs = append(errs, fmt.Errorf("%q: %w", word, err))
+		}
+	}
+	return errors.Join(errs...)
+}
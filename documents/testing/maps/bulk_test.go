@@ -0,0 +1,66 @@
+package maps
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestAddAll(t *testing.T) {
+	t.Run("all new words", func(t *testing.T) {
+		dictionary := Dictionary{"test": "this is just a test"}
+
+		err := dictionary.AddAll(map[string]string{
+			"go":   "a language",
+			"rust": "another language",
+		})
+
+		assertErrors(t, err, nil)
+		assertDefinition(t, dictionary, "go", "a language")
+		assertDefinition(t, dictionary, "rust", "another language")
+	})
+
+	t.Run("some words already exist", func(t *testing.T) {
+		dictionary := Dictionary{
+			"go":   "original go",
+			"test": "original test",
+		}
+
+		err := dictionary.AddAll(map[string]string{
+			"go":   "replacement go",
+			"new":  "a new word",
+			"test": "replacement test",
+		})
+
+		if !errors.Is(err, ErrWordExists) {
+			t.Fatalf("got error %v want it to match %v", err, ErrWordExists)
+		}
+		for _, word := range []string{`"go"`, `"test"`} {
+			if !strings.Contains(err.Error(), word) {
+				t.Errorf("expected error %q to mention %s", err, word)
+			}
+		}
+
+		// conflicting words keep their definitions, the rest are still added
+		want := Dictionary{
+			"go":   "original go",
+			"new":  "a new word",
+			"test": "original test",
+		}
+		if !reflect.DeepEqual(dictionary, want) {
+			t.Errorf("got %v want %v", dictionary, want)
+		}
+	})
+
+	t.Run("empty batch", func(t *testing.T) {
+		dictionary := Dictionary{}
+
+		err := dictionary.AddAll(map[string]string{})
+
+		assertErrors(t, err, nil)
+		if len(dictionary) != 0 {
+			t.Errorf("got %v want an empty dictionary", dictionary)
+		}
+	})
+}
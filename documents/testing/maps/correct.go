@@ -0,0 +1,51 @@
+package maps
+
+// Correct returns the dictionary word closest to word, as long as it is at most
+// maxEdits insertions, deletions or substitutions away. When several words are
+// equally close the alphabetically first one wins. If word is already in the
+// dictionary, or nothing is close enough, word is returned with changed false.
+func (d Dictionary) Correct(word string, maxEdits int) (corrected string, changed bool) {
+	if _, ok := d[word]; ok {
+		return word, false
+	}
+
+	best, bestDistance := "", maxEdits+1
+	for candidate := range d {
+		distance := levenshtein(word, candidate)
+		if distance < bestDistance || (distance == bestDistance && candidate < best) {
+			best, bestDistance = candidate, distance
+		}
+	}
+
+	if bestDistance > maxEdits {
+		return word, false
+	}
+	return best, true
+}
+
+// levenshtein returns the edit distance between a and b, comparing runes so
+// multi-byte characters count as a single edit. Only two rows of the DP table
+// are kept since each row only depends on the one above it.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(rb)]
+}
@@ -0,0 +1,61 @@
+package maps
+
+import (
+	"testing"
+)
+
+func TestCorrect(t *testing.T) {
+	dictionary := Dictionary{
+		"apple":  "a fruit",
+		"apply":  "to put to use",
+		"banana": "a long yellow fruit",
+		"cherry": "a small red fruit",
+		"café":   "a coffee shop",
+	}
+
+	cases := []struct {
+		name        string
+		word        string
+		maxEdits    int
+		want        string
+		wantChanged bool
+	}{
+		{"known word is unchanged", "banana", 2, "banana", false},
+		{"single substitution", "banena", 1, "banana", true},
+		{"missing letter", "chery", 1, "cherry", true},
+		{"prefers fewer edits", "appel", 2, "apple", true},
+		{"ties go to the alphabetically first word", "applx", 1, "apple", true},
+		{"accented letters count as one edit", "cafe", 1, "café", true},
+		{"too many edits is unchanged", "bnnaa", 1, "bnnaa", false},
+		{"nothing close is unchanged", "zebra", 2, "zebra", false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, changed := dictionary.Correct(c.word, c.maxEdits)
+			assertStrings(t, got, c.want)
+			if changed != c.wantChanged {
+				t.Errorf("got changed %t want %t", changed, c.wantChanged)
+			}
+		})
+	}
+}
+
+func TestLevenshtein(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"", "abc", 3},
+		{"kitten", "sitting", 3},
+		{"flaw", "lawn", 2},
+		{"same", "same", 0},
+	}
+
+	for _, c := range cases {
+		if got := levenshtein(c.a, c.b); got != c.want {
+			t.Errorf("levenshtein(%q, %q) = %d want %d", c.a, c.b, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package maps
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/aziz-shoko/dsa-go/helpers/maputil"
+)
+
+const ErrInvalidCSVHeader = DictionaryErr("CSV header must be word,definition")
+
+var csvHeader = []string{"word", "definition"}
+
+// WriteCSV writes the dictionary as CSV with a "word,definition" header row.
+// Words are written in sorted order so the output is the same on every run.
+func (d Dictionary) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvHeader); err != nil {
+		return err
+	}
+
+	for _, word := range maputil.SortedKeys(d) {
+		if err := writer.Write([]string{word, d[word]}); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// ReadCSV builds a Dictionary from CSV written by WriteCSV
+func ReadCSV(r io.Reader) (Dictionary, error) {
+	reader := csv.NewReader(r)
+	// read the header with any number of fields so a wrong header is reported
+	// as ErrInvalidCSVHeader, then hold the data rows to its width
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err == io.EOF || (err == nil && !slices.Equal(header, csvHeader)) {
+		return nil, ErrInvalidCSVHeader
+	}
+	if err != nil {
+		return nil, err
+	}
+	reader.FieldsPerRecord = len(csvHeader)
+
+	d := Dictionary{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return d, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if err := d.Add(record[0], record[1]); err != nil {
+			return nil, fmt.Errorf("reading %q: %w", record[0], err)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package maps
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCSV(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		dictionary := Dictionary{
+			"test":  "this is just a test",
+			"comma": "first, second",
+			"quote": `she said "hi"`,
+			"apple": "a fruit",
+		}
+
+		var buf bytes.Buffer
+		if err := dictionary.WriteCSV(&buf); err != nil {
+			t.Fatalf("did not expect an error but got one %v", err)
+		}
+
+		got, err := ReadCSV(&buf)
+		if err != nil {
+			t.Fatalf("did not expect an error but got one %v", err)
+		}
+		if !reflect.DeepEqual(got, dictionary) {
+			t.Errorf("got %v want %v", got, dictionary)
+		}
+	})
+
+	t.Run("writes sorted rows after the header", func(t *testing.T) {
+		dictionary := Dictionary{"b": "second, letter", "a": "first"}
+
+		var buf bytes.Buffer
+		dictionary.WriteCSV(&buf)
+
+		want := "word,definition\na,first\nb,\"second, letter\"\n"
+		assertStrings(t, buf.String(), want)
+	})
+
+	t.Run("rejects a missing header", func(t *testing.T) {
+		_, err := ReadCSV(strings.NewReader("test,this is just a test\n"))
+		assertErrors(t, err, ErrInvalidCSVHeader)
+	})
+
+	t.Run("rejects a header with extra columns", func(t *testing.T) {
+		_, err := ReadCSV(strings.NewReader("word,definition,extra\ntest,a test,more\n"))
+		assertErrors(t, err, ErrInvalidCSVHeader)
+	})
+
+	t.Run("rejects a row with the wrong number of fields", func(t *testing.T) {
+		_, err := ReadCSV(strings.NewReader("word,definition\ntest,a test,more\n"))
+		var parseErr *csv.ParseError
+		if !errors.As(err, &parseErr) {
+			t.Errorf("got error %v want a *csv.ParseError", err)
+		}
+	})
+
+	t.Run("rejects duplicate words", func(t *testing.T) {
+		_, err := ReadCSV(strings.NewReader("word,definition\na,one\na,two\n"))
+		if !errors.Is(err, ErrWordExists) {
+			t.Errorf("got error %v want %v", err, ErrWordExists)
+		}
+	})
+}
@@ -0,0 +1,19 @@
+package maps
+
+import (
+	"github.com/aziz-shoko/dsa-go/helpers/maputil"
+)
+
+// Len returns the number of words in the dictionary
+func (d Dictionary) Len() int {
+	return len(d)
+}
+
+// Each calls fn for every entry in alphabetical order of word, so output built
+// from it is the same on every run. Adding, updating or deleting words from
+// inside fn is undefined behaviour.
+func (d Dictionary) Each(fn func(word, definition string)) {
+	for _, word := range maputil.SortedKeys(d) {
+		fn(word, d[word])
+	}
+}
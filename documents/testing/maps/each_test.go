@@ -0,0 +1,44 @@
+package maps
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestLen(t *testing.T) {
+	t.Run("empty dictionary", func(t *testing.T) {
+		if got := (Dictionary{}).Len(); got != 0 {
+			t.Errorf("got %d want 0", got)
+		}
+	})
+
+	t.Run("populated dictionary", func(t *testing.T) {
+		dictionary := Dictionary{"a": "1", "b": "2", "c": "3"}
+		if got := dictionary.Len(); got != 3 {
+			t.Errorf("got %d want 3", got)
+		}
+	})
+}
+
+func TestEach(t *testing.T) {
+	dictionary := Dictionary{
+		"pear":   "a green fruit",
+		"apple":  "a red fruit",
+		"mango":  "a tropical fruit",
+		"banana": "a yellow fruit",
+	}
+
+	var words, definitions []string
+	dictionary.Each(func(word, definition string) {
+		words = append(words, word)
+		definitions = append(definitions, definition)
+	})
+
+	wantWords := []string{"apple", "banana", "mango", "pear"}
+	if !slices.Equal(words, wantWords) {
+		t.Errorf("got words %v want %v", words, wantWords)
+	}
+	for i, word := range words {
+		assertStrings(t, definitions[i], dictionary[word])
+	}
+}
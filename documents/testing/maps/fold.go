@@ -0,0 +1,24 @@
+package maps
+
+import (
+	"strings"
+)
+
+// SearchFold is Search ignoring case, using Unicode simple case folding so
+// "GO", "Go" and "go" all match, as do Greek "ΣΟΦΙΑ" and "σοφια". Folding is
+// not locale aware, so the Turkish dotted "İ" does not match a plain "i".
+// If several words match, the definition of the first in sorted word order is
+// returned, so "Go" wins over "go" and the result doesn't depend on map order.
+// It scans every word once, keeping the smallest match, rather than sorting.
+func (d Dictionary) SearchFold(word string) (string, error) {
+	best, found := "", false
+	for key := range d {
+		if strings.EqualFold(key, word) && (!found || key < best) {
+			best, found = key, true
+		}
+	}
+	if !found {
+		return "", ErrNotFound
+	}
+	return d[best], nil
+}
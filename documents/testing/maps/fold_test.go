@@ -0,0 +1,43 @@
+package maps
+
+import (
+	"testing"
+)
+
+func TestSearchFold(t *testing.T) {
+	dictionary := Dictionary{
+		"Go":       "a programming language",
+		"go":       "to move",
+		"Gopher":   "the Go mascot",
+		"σοφία":    "wisdom",
+		"İstanbul": "a city",
+	}
+
+	t.Run("mixed case hit", func(t *testing.T) {
+		got, err := dictionary.SearchFold("gOPHER")
+		assertErrors(t, err, nil)
+		assertStrings(t, got, "the Go mascot")
+	})
+
+	t.Run("several matches return the first in sorted order", func(t *testing.T) {
+		got, err := dictionary.SearchFold("GO")
+		assertErrors(t, err, nil)
+		assertStrings(t, got, "a programming language")
+	})
+
+	t.Run("unicode case folding", func(t *testing.T) {
+		got, err := dictionary.SearchFold("ΣΟΦΊΑ")
+		assertErrors(t, err, nil)
+		assertStrings(t, got, "wisdom")
+	})
+
+	t.Run("dotted capital I does not fold to i", func(t *testing.T) {
+		_, err := dictionary.SearchFold("istanbul")
+		assertErrors(t, err, ErrNotFound)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := dictionary.SearchFold("rust")
+		assertErrors(t, err, ErrNotFound)
+	})
+}
@@ -0,0 +1,40 @@
+package maps
+
+import (
+	"encoding/gob"
+	"fmt"
+	"io"
+)
+
+const ErrUnsupportedVersion = DictionaryErr("unsupported dictionary format version")
+
+// formatVersion is written as the first byte of every encoded dictionary.
+// Bump it whenever the encoding changes so old readers fail loudly instead of misreading data.
+const formatVersion byte = 1
+
+// Encode writes the dictionary in a binary format: a single version byte
+// followed by the gob encoded entries
+func (d Dictionary) Encode(w io.Writer) error {
+	if _, err := w.Write([]byte{formatVersion}); err != nil {
+		return err
+	}
+	return gob.NewEncoder(w).Encode(map[string]string(d))
+}
+
+// Decode reads a dictionary written by Encode. It returns ErrUnsupportedVersion
+// if the data was written with a different format version.
+func Decode(r io.Reader) (Dictionary, error) {
+	version := make([]byte, 1)
+	if _, err := io.ReadFull(r, version); err != nil {
+		return nil, fmt.Errorf("reading format version: %w", err)
+	}
+	if version[0] != formatVersion {
+		return nil, fmt.Errorf("%w: got %d, want %d", ErrUnsupportedVersion, version[0], formatVersion)
+	}
+
+	d := Dictionary{}
+	if err := gob.NewDecoder(r).Decode(&d); err != nil {
+		return nil, fmt.Errorf("decoding dictionary: %w", err)
+	}
+	return d, nil
+}
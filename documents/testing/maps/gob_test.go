@@ -0,0 +1,49 @@
+package maps
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		dictionary := Dictionary{
+			"test":    "this is just a test",
+			"example": "definition of example",
+		}
+
+		var buf bytes.Buffer
+		if err := dictionary.Encode(&buf); err != nil {
+			t.Fatalf("did not expect an error but got one %v", err)
+		}
+
+		got, err := Decode(&buf)
+		if err != nil {
+			t.Fatalf("did not expect an error but got one %v", err)
+		}
+		if !reflect.DeepEqual(got, dictionary) {
+			t.Errorf("got %v want %v", got, dictionary)
+		}
+	})
+
+	t.Run("tampered version byte", func(t *testing.T) {
+		var buf bytes.Buffer
+		Dictionary{"test": "this is just a test"}.Encode(&buf)
+
+		data := buf.Bytes()
+		data[0] = formatVersion + 1
+
+		_, err := Decode(bytes.NewReader(data))
+		if !errors.Is(err, ErrUnsupportedVersion) {
+			t.Errorf("got error %v want %v", err, ErrUnsupportedVersion)
+		}
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		if _, err := Decode(&bytes.Buffer{}); err == nil {
+			t.Error("expected an error but didn't get one")
+		}
+	})
+}
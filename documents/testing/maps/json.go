@@ -0,0 +1,29 @@
+package maps
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// MarshalJSON encodes the dictionary as a JSON object of word to definition.
+// encoding/json writes map keys in sorted order, so the output is the same on
+// every run and diffs between saved dictionaries stay small.
+func (d Dictionary) MarshalJSON() ([]byte, error) {
+	// convert to the underlying map type so json doesn't call MarshalJSON again
+	return json.Marshal(map[string]string(d))
+}
+
+// LoadJSON reads a dictionary written by MarshalJSON. An empty object, or null,
+// gives an empty non-nil Dictionary.
+func LoadJSON(r io.Reader) (Dictionary, error) {
+	var entries map[string]string
+	if err := json.NewDecoder(r).Decode(&entries); err != nil {
+		return nil, fmt.Errorf("decoding dictionary JSON: %w", err)
+	}
+
+	if entries == nil {
+		return Dictionary{}, nil
+	}
+	return Dictionary(entries), nil
+}
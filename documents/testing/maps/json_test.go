@@ -0,0 +1,72 @@
+package maps
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestJSON(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		dictionary := Dictionary{
+			"test":    "this is just a test",
+			"example": "definition of example",
+			"quote":   `says "hi" & leaves`,
+		}
+
+		data, err := json.Marshal(dictionary)
+		if err != nil {
+			t.Fatalf("did not expect an error but got one %v", err)
+		}
+
+		got, err := LoadJSON(bytes.NewReader(data))
+		if err != nil {
+			t.Fatalf("did not expect an error but got one %v", err)
+		}
+		if !reflect.DeepEqual(got, dictionary) {
+			t.Errorf("got %v want %v", got, dictionary)
+		}
+	})
+
+	t.Run("keys are written in sorted order", func(t *testing.T) {
+		dictionary := Dictionary{"b": "2", "c": "3", "a": "1"}
+
+		data, err := dictionary.MarshalJSON()
+		if err != nil {
+			t.Fatalf("did not expect an error but got one %v", err)
+		}
+		assertStrings(t, string(data), `{"a":"1","b":"2","c":"3"}`)
+	})
+
+	t.Run("empty object", func(t *testing.T) {
+		got, err := LoadJSON(strings.NewReader("{}"))
+		if err != nil {
+			t.Fatalf("did not expect an error but got one %v", err)
+		}
+		if got == nil || len(got) != 0 {
+			t.Errorf("got %#v want an empty non nil Dictionary", got)
+		}
+	})
+
+	t.Run("malformed JSON", func(t *testing.T) {
+		_, err := LoadJSON(strings.NewReader(`{"test": `))
+		if err == nil {
+			t.Fatal("expected an error but got none")
+		}
+		if errors.Unwrap(err) == nil {
+			t.Errorf("expected a wrapped error but got %v", err)
+		}
+	})
+
+	t.Run("wrong JSON type", func(t *testing.T) {
+		_, err := LoadJSON(strings.NewReader(`["not", "an", "object"]`))
+
+		var typeErr *json.UnmarshalTypeError
+		if !errors.As(err, &typeErr) {
+			t.Errorf("got error %v want a wrapped *json.UnmarshalTypeError", err)
+		}
+	})
+}
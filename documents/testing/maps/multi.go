@@ -0,0 +1,20 @@
+package maps
+
+// MultiDictionary is a Dictionary where a word can have several definitions,
+// kept in the order they were added
+type MultiDictionary map[string][]string
+
+// AddSense adds definition as another meaning of word, creating the word if it is new
+func (d MultiDictionary) AddSense(word, definition string) {
+	d[word] = append(d[word], definition)
+}
+
+// Senses returns the definitions of word in the order they were added, or
+// ErrNotFound. The result is a copy, changing it doesn't change the dictionary.
+func (d MultiDictionary) Senses(word string) ([]string, error) {
+	senses, ok := d[word]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return append([]string(nil), senses...), nil
+}
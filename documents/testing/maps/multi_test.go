@@ -0,0 +1,51 @@
+package maps
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMultiDictionary(t *testing.T) {
+	t.Run("senses keep the order they were added", func(t *testing.T) {
+		dictionary := MultiDictionary{}
+		dictionary.AddSense("bank", "the side of a river")
+		dictionary.AddSense("bank", "a place that keeps money")
+		dictionary.AddSense("bank", "to tilt an aircraft")
+
+		got, err := dictionary.Senses("bank")
+		assertErrors(t, err, nil)
+
+		want := []string{"the side of a river", "a place that keeps money", "to tilt an aircraft"}
+		if !slices.Equal(got, want) {
+			t.Errorf("got %v want %v", got, want)
+		}
+	})
+
+	t.Run("a new word gets a single sense", func(t *testing.T) {
+		dictionary := MultiDictionary{}
+		dictionary.AddSense("test", "this is just a test")
+
+		got, err := dictionary.Senses("test")
+		assertErrors(t, err, nil)
+
+		if !slices.Equal(got, []string{"this is just a test"}) {
+			t.Errorf("got %v want [this is just a test]", got)
+		}
+	})
+
+	t.Run("changing the result does not change the dictionary", func(t *testing.T) {
+		dictionary := MultiDictionary{}
+		dictionary.AddSense("test", "original")
+
+		got, _ := dictionary.Senses("test")
+		got[0] = "changed"
+
+		again, _ := dictionary.Senses("test")
+		assertStrings(t, again[0], "original")
+	})
+
+	t.Run("unknown word", func(t *testing.T) {
+		_, err := MultiDictionary{}.Senses("unknown")
+		assertErrors(t, err, ErrNotFound)
+	})
+}
@@ -0,0 +1,49 @@
+package maps
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// NormalizedDictionary is a Dictionary that ignores case and accents in words,
+// so "Café", "cafe" and "CAFE" are all the same entry.
+type NormalizedDictionary struct {
+	entries Dictionary
+}
+
+// NewNormalized creates an empty NormalizedDictionary
+func NewNormalized() *NormalizedDictionary {
+	return &NormalizedDictionary{entries: Dictionary{}}
+}
+
+// normalize folds word to a canonical key: it decomposes accented characters
+// (NFD turns "é" into "e" followed by a combining acute accent), drops the
+// combining marks and lowercases what is left
+func normalize(word string) string {
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	folded, _, err := transform.String(t, word)
+	if err != nil {
+		folded = word
+	}
+	return strings.ToLower(folded)
+}
+
+func (d *NormalizedDictionary) Search(word string) (string, error) {
+	return d.entries.Search(normalize(word))
+}
+
+func (d *NormalizedDictionary) Add(word, definition string) error {
+	return d.entries.Add(normalize(word), definition)
+}
+
+func (d *NormalizedDictionary) Update(word, definition string) error {
+	return d.entries.Update(normalize(word), definition)
+}
+
+func (d *NormalizedDictionary) Delete(word string) {
+	d.entries.Delete(normalize(word))
+}
@@ -0,0 +1,54 @@
+package maps
+
+import (
+	"testing"
+)
+
+func TestNormalizedDictionary(t *testing.T) {
+	t.Run("accented word is found without the accent", func(t *testing.T) {
+		dictionary := NewNormalized()
+		dictionary.Add("café", "a place to drink coffee")
+
+		got, err := dictionary.Search("cafe")
+		assertErrors(t, err, nil)
+		assertStrings(t, got, "a place to drink coffee")
+	})
+
+	t.Run("plain word is found with an accent and different case", func(t *testing.T) {
+		dictionary := NewNormalized()
+		dictionary.Add("naive", "lacking experience")
+
+		got, err := dictionary.Search("NAÏVE")
+		assertErrors(t, err, nil)
+		assertStrings(t, got, "lacking experience")
+	})
+
+	t.Run("normalized forms collide on add", func(t *testing.T) {
+		dictionary := NewNormalized()
+		dictionary.Add("résumé", "a summary of experience")
+
+		err := dictionary.Add("resume", "to start again")
+		assertErrors(t, err, ErrWordExists)
+	})
+
+	t.Run("update and delete use the normalized word", func(t *testing.T) {
+		dictionary := NewNormalized()
+		dictionary.Add("über", "over")
+
+		assertErrors(t, dictionary.Update("Uber", "above"), nil)
+		got, _ := dictionary.Search("über")
+		assertStrings(t, got, "above")
+
+		dictionary.Delete("UBER")
+		_, err := dictionary.Search("über")
+		assertErrors(t, err, ErrNotFound)
+	})
+
+	t.Run("default dictionary stays exact match", func(t *testing.T) {
+		dictionary := Dictionary{}
+		dictionary.Add("café", "a place to drink coffee")
+
+		_, err := dictionary.Search("cafe")
+		assertErrors(t, err, ErrNotFound)
+	})
+}
@@ -0,0 +1,20 @@
+package maps
+
+import (
+	"strings"
+
+	"github.com/aziz-shoko/dsa-go/helpers/maputil"
+)
+
+// SearchPrefix returns every word starting with prefix in alphabetical order.
+// An empty prefix matches every word. When nothing matches the result is an
+// empty slice, never nil.
+func (d Dictionary) SearchPrefix(prefix string) []string {
+	words := []string{}
+	for _, word := range maputil.SortedKeys(d) {
+		if strings.HasPrefix(word, prefix) {
+			words = append(words, word)
+		}
+	}
+	return words
+}
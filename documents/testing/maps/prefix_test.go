@@ -0,0 +1,41 @@
+package maps
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSearchPrefix(t *testing.T) {
+	dictionary := Dictionary{
+		"car":    "a vehicle",
+		"card":   "a piece of stiff paper",
+		"care":   "attention",
+		"cat":    "a small animal",
+		"dog":    "a loyal animal",
+		"carpet": "a floor covering",
+	}
+
+	cases := []struct {
+		name   string
+		prefix string
+		want   []string
+	}{
+		{"no matches", "zebra", []string{}},
+		{"several matches", "car", []string{"car", "card", "care", "carpet"}},
+		{"exact full word", "carpet", []string{"carpet"}},
+		{"empty prefix returns every word", "", []string{"car", "card", "care", "carpet", "cat", "dog"}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := dictionary.SearchPrefix(c.prefix)
+
+			if got == nil {
+				t.Fatal("expected a non nil slice")
+			}
+			if !slices.Equal(got, c.want) {
+				t.Errorf("got %v want %v", got, c.want)
+			}
+		})
+	}
+}
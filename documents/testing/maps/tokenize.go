@@ -0,0 +1,47 @@
+package maps
+
+import (
+	"strings"
+	"unicode"
+)
+
+// TokenizeOptions controls how Tokenize splits text into words
+type TokenizeOptions struct {
+	// Lowercase converts every token to lower case
+	Lowercase bool
+	// StripPunctuation removes punctuation characters from tokens
+	StripPunctuation bool
+	// Delimiters is the set of characters tokens are split on.
+	// When empty, text is split on whitespace.
+	Delimiters string
+}
+
+// Tokenize splits text into tokens in the order they appear, applying opts.
+// Tokens left empty after splitting or stripping are dropped.
+func Tokenize(text string, opts TokenizeOptions) []string {
+	isDelimiter := unicode.IsSpace
+	if opts.Delimiters != "" {
+		isDelimiter = func(r rune) bool {
+			return strings.ContainsRune(opts.Delimiters, r)
+		}
+	}
+
+	tokens := []string{}
+	for _, field := range strings.FieldsFunc(text, isDelimiter) {
+		if opts.StripPunctuation {
+			field = strings.Map(func(r rune) rune {
+				if unicode.IsPunct(r) {
+					return -1
+				}
+				return r
+			}, field)
+		}
+		if opts.Lowercase {
+			field = strings.ToLower(field)
+		}
+		if field != "" {
+			tokens = append(tokens, field)
+		}
+	}
+	return tokens
+}
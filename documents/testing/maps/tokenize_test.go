@@ -0,0 +1,59 @@
+package maps
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	cases := []struct {
+		name string
+		text string
+		opts TokenizeOptions
+		want []string
+	}{
+		{
+			name: "splits on whitespace by default",
+			text: "the quick\tbrown\n fox",
+			want: []string{"the", "quick", "brown", "fox"},
+		},
+		{
+			name: "strips punctuation",
+			text: "Hello, world! It's (really) here...",
+			opts: TokenizeOptions{StripPunctuation: true},
+			want: []string{"Hello", "world", "Its", "really", "here"},
+		},
+		{
+			name: "drops tokens that were only punctuation",
+			text: "wait - what ?",
+			opts: TokenizeOptions{StripPunctuation: true},
+			want: []string{"wait", "what"},
+		},
+		{
+			name: "custom delimiters",
+			text: "go,rust;;zig, c",
+			opts: TokenizeOptions{Delimiters: ",; "},
+			want: []string{"go", "rust", "zig", "c"},
+		},
+		{
+			name: "lowercasing mixed case",
+			text: "The THE the ThE",
+			opts: TokenizeOptions{Lowercase: true},
+			want: []string{"the", "the", "the", "the"},
+		},
+		{
+			name: "empty text",
+			text: "   ",
+			want: []string{},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Tokenize(tt.text, tt.opts)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got %q want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"io"
 	"os"
 	"fmt"
+	"strconv"
 	"time"
 )
 
@@ -27,15 +28,48 @@ func (c *ConfigurableSleeper) Sleep() {
 	c.sleep(c.duration)
 }
 
+// SleeperOption configures a ConfigurableSleeper built with NewSleeper
+type SleeperOption func(*ConfigurableSleeper)
+
+// WithDuration sets how long each Sleep lasts
+func WithDuration(d time.Duration) SleeperOption {
+	return func(c *ConfigurableSleeper) {
+		c.duration = d
+	}
+}
+
+// WithSleepFunc sets the function used to sleep, handy for spying in tests
+func WithSleepFunc(fn func(time.Duration)) SleeperOption {
+	return func(c *ConfigurableSleeper) {
+		c.sleep = fn
+	}
+}
+
+// NewSleeper builds a ConfigurableSleeper that sleeps for 1 second using time.Sleep
+// unless overridden by opts
+func NewSleeper(opts ...SleeperOption) *ConfigurableSleeper {
+	c := &ConfigurableSleeper{duration: 1 * time.Second, sleep: time.Sleep}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
 func main() {
-	sleeper := &ConfigurableSleeper{1 * time.Second, time.Sleep}
+	sleeper := NewSleeper()
 	Countdown(os.Stdout, sleeper)
 }
 
 func Countdown(out io.Writer, sleep Sleeper) {
-	for i := coundownStart; i > 0; i-- {
-		fmt.Fprintln(out, i)
+	CountdownFormatted(out, sleep, coundownStart, strconv.Itoa)
+}
+
+// CountdownFormatted counts down from start to 1, printing format(i) on its own line
+// and sleeping after each step, then prints the final word
+func CountdownFormatted(out io.Writer, sleep Sleeper, start int, format func(i int) string) {
+	for i := start; i > 0; i-- {
+		fmt.Fprintln(out, format(i))
 		sleep.Sleep()
-	}	
+	}
 	fmt.Fprint(out, finalWord)
 }
\ No newline at end of file
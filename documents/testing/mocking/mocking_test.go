@@ -2,6 +2,7 @@ package main
 
 import (
 	"bytes"
+	"fmt"
 	"slices"
 	"testing"
 	"time"
@@ -67,6 +68,25 @@ Go!`
 	})
 }
 
+func TestCountdownFormatted(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	liftoff := func(i int) string {
+		return fmt.Sprintf("T-%d", i)
+	}
+
+	CountdownFormatted(buffer, &SpyCountdownOperations{}, 3, liftoff)
+
+	got := buffer.String()
+	want := `T-3
+T-2
+T-1
+Go!`
+
+	if got != want {
+		t.Errorf("got %q want %q", got, want)
+	}
+}
+
 func TestConfigurableSleeper(t *testing.T) {
 	sleepTime := 5 * time.Second
 
@@ -77,4 +97,29 @@ func TestConfigurableSleeper(t *testing.T) {
 	if spyTime.durationSlept != sleepTime {
 		t.Errorf("should have slept for %v but slept for %v", sleepTime, spyTime.durationSlept)	
 	}
-}
\ No newline at end of file
+}
+
+func TestNewSleeper(t *testing.T) {
+	t.Run("sleeps with the configured duration and function", func(t *testing.T) {
+		sleepTime := 5 * time.Second
+		spyTime := &SpyTime{}
+
+		sleeper := NewSleeper(WithDuration(sleepTime), WithSleepFunc(spyTime.Sleep))
+		sleeper.Sleep()
+
+		if spyTime.durationSlept != sleepTime {
+			t.Errorf("should have slept for %v but slept for %v", sleepTime, spyTime.durationSlept)
+		}
+	})
+
+	t.Run("defaults to one second", func(t *testing.T) {
+		spyTime := &SpyTime{}
+
+		sleeper := NewSleeper(WithSleepFunc(spyTime.Sleep))
+		sleeper.Sleep()
+
+		if spyTime.durationSlept != 1*time.Second {
+			t.Errorf("should have slept for %v but slept for %v", 1*time.Second, spyTime.durationSlept)
+		}
+	})
+}
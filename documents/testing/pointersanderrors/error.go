@@ -3,6 +3,10 @@ package pointersanderrors
 import (
 	"errors"
 	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
 )
 
 // defined errors
@@ -10,27 +14,177 @@ var ErrInsufficientFunds = errors.New("cannot withdraw, insufficient funds ")
 
 type Bitcoin int
 
+// TransactionKind says whether a Transaction added or removed funds
+type TransactionKind int
+
+const (
+	KindDeposit TransactionKind = iota
+	KindWithdraw
+)
+
+func (k TransactionKind) String() string {
+	switch k {
+	case KindDeposit:
+		return "deposit"
+	case KindWithdraw:
+		return "withdraw"
+	default:
+		return "unknown"
+	}
+}
+
+// Transaction is one successful Deposit or Withdraw recorded in a Wallet's history
+type Transaction struct {
+	Amount Bitcoin
+	Kind   TransactionKind
+	Time   time.Time
+}
+
+// Wallet is safe for concurrent use. It must not be copied after first use.
 type Wallet struct {
-	balance Bitcoin
+	mu          sync.Mutex
+	id          atomic.Uint64 // set on first use by lockID
+	balance     Bitcoin
+	creditLimit Bitcoin
+	history     []Transaction
+	now         func() time.Time
+}
+
+// NewWallet creates an empty Wallet that timestamps transactions with now,
+// so tests can pass a fixed clock. A nil now, like a zero value Wallet, uses time.Now.
+func NewWallet(now func() time.Time) *Wallet {
+	return &Wallet{now: now}
 }
 
 // Wallet methods
 func (w *Wallet) Balance() Bitcoin {
+	w.mu.Lock()
+	defer w.mu.Unlock()
 	return w.balance
 }
 
 func (w *Wallet) Deposit(amount Bitcoin) {
-	w.balance += amount
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.deposit(amount)
+}
+
+// SetCreditLimit lets the balance go as low as -limit, like an overdraft.
+// The default limit is zero, so the balance can't go below zero.
+func (w *Wallet) SetCreditLimit(limit Bitcoin) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.creditLimit = limit
 }
 
 func (w *Wallet) Withdraw(amount Bitcoin) error {
-	if amount > w.balance {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.withdraw(amount)
+}
+
+// History returns every successful transaction, oldest first.
+// Failed withdrawals are not recorded.
+func (w *Wallet) History() []Transaction {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return append([]Transaction(nil), w.history...)
+}
+
+// String renders the balance, so a Wallet prints like the Bitcoin it holds
+func (w *Wallet) String() string {
+	return w.Balance().String()
+}
+
+// Statement writes every transaction, oldest first, followed by the balance:
+//
+//	2024-01-01 12:01 deposit  50 BTC
+//	2024-01-01 12:02 withdraw 20 BTC
+//	Balance: 30 BTC
+func (w *Wallet) Statement(out io.Writer) {
+	// take a consistent snapshot, then write without holding the lock
+	w.mu.Lock()
+	history := append([]Transaction(nil), w.history...)
+	balance := w.balance
+	w.mu.Unlock()
+
+	for _, tx := range history {
+		fmt.Fprintf(out, "%s %-8s %s\n", tx.Time.Format("2006-01-02 15:04"), tx.Kind, tx.Amount)
+	}
+	fmt.Fprintf(out, "Balance: %s\n", balance)
+}
+
+// deposit adds amount to the balance, the caller must hold w.mu
+func (w *Wallet) deposit(amount Bitcoin) {
+	w.balance += amount
+	w.record(amount, KindDeposit)
+}
+
+// withdraw removes amount if the credit limit allows it, the caller must hold w.mu
+func (w *Wallet) withdraw(amount Bitcoin) error {
+	if amount > w.balance+w.creditLimit {
 		return ErrInsufficientFunds
 	}
 	w.balance -= amount
+	w.record(amount, KindWithdraw)
 	return nil
 }
 
+// record appends a transaction to the history, the caller must hold w.mu
+func (w *Wallet) record(amount Bitcoin, kind TransactionKind) {
+	now := w.now
+	if now == nil {
+		now = time.Now
+	}
+	w.history = append(w.history, Transaction{Amount: amount, Kind: kind, Time: now()})
+}
+
+// Transfer moves amount from one wallet to another. Both wallets stay locked for
+// the whole move, so other goroutines see either the balances before or after it.
+// When from can't cover amount ErrInsufficientFunds is returned and neither balance moves.
+// Transferring from a wallet to itself does nothing and succeeds.
+func Transfer(from, to *Wallet, amount Bitcoin) error {
+	if from == to {
+		return nil
+	}
+	unlock := lockPair(from, to)
+	defer unlock()
+
+	if err := from.withdraw(amount); err != nil {
+		return err
+	}
+	to.deposit(amount)
+	return nil
+}
+
+// walletIDs hands out the ids that order wallet locks, starting from 1
+var walletIDs atomic.Uint64
+
+// lockID returns the id of w, giving it the next free one on first use.
+// If two goroutines race to set it the first one wins and both see its id.
+func (w *Wallet) lockID() uint64 {
+	if id := w.id.Load(); id != 0 {
+		return id
+	}
+	w.id.CompareAndSwap(0, walletIDs.Add(1))
+	return w.id.Load()
+}
+
+// lockPair locks two different wallets and returns a function that unlocks them.
+// The locks are always taken lowest id first, so two transfers going in
+// opposite directions between the same wallets can't deadlock.
+func lockPair(a, b *Wallet) (unlock func()) {
+	if b.lockID() < a.lockID() {
+		a, b = b, a
+	}
+	a.mu.Lock()
+	b.mu.Lock()
+	return func() {
+		b.mu.Unlock()
+		a.mu.Unlock()
+	}
+}
+
 // Bitcoin methods
 func (b Bitcoin) String() string {
 	return fmt.Sprintf("%d BTC", b)
@@ -1,34 +1,258 @@
 package pointersanderrors
 
 import (
+	"bytes"
+	"reflect"
+	"sync"
 	"testing"
+	"time"
 )
 func TestWallet(t *testing.T) {
 
 	t.Run("deposit", func(t *testing.T) {
 		wallet := Wallet{}
 		wallet.Deposit(Bitcoin(10))
-		assertBalance(t, wallet, Bitcoin(10))
+		assertBalance(t, &wallet, Bitcoin(10))
 	})
 
 	t.Run("withdraw with funds", func(t *testing.T) {
-		wallet := Wallet{Bitcoin(20)}
+		wallet := Wallet{balance: Bitcoin(20)}
 		err := wallet.Withdraw(Bitcoin(10))
 
 		assertNoError(t, err)
-		assertBalance(t, wallet, Bitcoin(10))
+		assertBalance(t, &wallet, Bitcoin(10))
 	})
 
 	t.Run("withdraw insufficient funds", func(t *testing.T) {
-		wallet := Wallet{Bitcoin(20)}
+		wallet := Wallet{balance: Bitcoin(20)}
 		err := wallet.Withdraw(Bitcoin(100))
 
 		assertError(t, err, ErrInsufficientFunds)
-		assertBalance(t, wallet, Bitcoin(20))
+		assertBalance(t, &wallet, Bitcoin(20))
 	})
 }
 
-func assertBalance(t testing.TB, wallet Wallet, want Bitcoin) {
+// run with 'go test -race' to make sure the wallet is safe
+func TestWalletConcurrent(t *testing.T) {
+	wallet := Wallet{}
+	const depositors = 100
+
+	var wg sync.WaitGroup
+	wg.Add(depositors)
+	for i := 1; i <= depositors; i++ {
+		go func(amount Bitcoin) {
+			defer wg.Done()
+			wallet.Deposit(amount)
+			wallet.Balance()
+		}(Bitcoin(i))
+	}
+	wg.Wait()
+
+	// 1 + 2 + ... + depositors
+	assertBalance(t, &wallet, Bitcoin(depositors*(depositors+1)/2))
+	if got := len(wallet.History()); got != depositors {
+		t.Errorf("got %d transactions want %d", got, depositors)
+	}
+}
+
+func TestWalletCreditLimit(t *testing.T) {
+	t.Run("withdraw into the allowed negative range", func(t *testing.T) {
+		wallet := Wallet{balance: Bitcoin(10)}
+		wallet.SetCreditLimit(Bitcoin(50))
+
+		err := wallet.Withdraw(Bitcoin(30))
+
+		assertNoError(t, err)
+		assertBalance(t, &wallet, Bitcoin(-20))
+	})
+
+	t.Run("withdraw exactly down to the limit", func(t *testing.T) {
+		wallet := Wallet{balance: Bitcoin(10)}
+		wallet.SetCreditLimit(Bitcoin(50))
+
+		err := wallet.Withdraw(Bitcoin(60))
+
+		assertNoError(t, err)
+		assertBalance(t, &wallet, Bitcoin(-50))
+	})
+
+	t.Run("exceeding the limit by one", func(t *testing.T) {
+		wallet := Wallet{balance: Bitcoin(10)}
+		wallet.SetCreditLimit(Bitcoin(50))
+
+		err := wallet.Withdraw(Bitcoin(61))
+
+		assertError(t, err, ErrInsufficientFunds)
+		assertBalance(t, &wallet, Bitcoin(10))
+	})
+}
+
+func TestTransfer(t *testing.T) {
+	t.Run("successful transfer", func(t *testing.T) {
+		from := Wallet{balance: Bitcoin(30)}
+		to := Wallet{balance: Bitcoin(5)}
+
+		err := Transfer(&from, &to, Bitcoin(20))
+
+		assertNoError(t, err)
+		assertBalance(t, &from, Bitcoin(10))
+		assertBalance(t, &to, Bitcoin(25))
+	})
+
+	t.Run("insufficient funds leaves both balances intact", func(t *testing.T) {
+		from := Wallet{balance: Bitcoin(10)}
+		to := Wallet{balance: Bitcoin(5)}
+
+		err := Transfer(&from, &to, Bitcoin(11))
+
+		assertError(t, err, ErrInsufficientFunds)
+		assertBalance(t, &from, Bitcoin(10))
+		assertBalance(t, &to, Bitcoin(5))
+	})
+
+	t.Run("transfer to self is a no-op", func(t *testing.T) {
+		wallet := Wallet{balance: Bitcoin(10)}
+
+		err := Transfer(&wallet, &wallet, Bitcoin(100))
+
+		assertNoError(t, err)
+		assertBalance(t, &wallet, Bitcoin(10))
+	})
+}
+
+// run with 'go test -race', opposing transfers must neither deadlock nor lose funds
+func TestTransferConcurrent(t *testing.T) {
+	// each wallet can cover every transfer out of it, whatever order they run in
+	a := Wallet{balance: Bitcoin(2000)}
+	b := Wallet{balance: Bitcoin(2000)}
+	const total, transfers = Bitcoin(4000), 500
+
+	// the observer takes both locks so it sees a consistent pair of balances,
+	// the total must never dip while money is in flight between the wallets
+	done := make(chan struct{})
+	observed := make(chan Bitcoin)
+	go func() {
+		defer close(observed)
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			unlock := lockPair(&a, &b)
+			sum := a.balance + b.balance
+			unlock()
+			if sum != total {
+				observed <- sum
+				return
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	wg.Add(2 * transfers)
+	for i := 0; i < transfers; i++ {
+		go func() {
+			defer wg.Done()
+			Transfer(&a, &b, Bitcoin(3))
+		}()
+		go func() {
+			defer wg.Done()
+			Transfer(&b, &a, Bitcoin(2))
+		}()
+	}
+	wg.Wait()
+	close(done)
+
+	if sum, ok := <-observed; ok {
+		t.Errorf("observed a total of %s mid transfer want %s", sum, total)
+	}
+	if got := a.Balance() + b.Balance(); got != total {
+		t.Errorf("got a total of %s want %s", got, total)
+	}
+	assertBalance(t, &a, Bitcoin(2000-transfers))
+	assertBalance(t, &b, Bitcoin(2000+transfers))
+}
+
+func TestWalletLockID(t *testing.T) {
+	var a, b Wallet
+
+	first := a.lockID()
+	if first == 0 {
+		t.Fatal("got id 0, ids start from 1")
+	}
+	if again := a.lockID(); again != first {
+		t.Errorf("got id %d then %d, want the same id every time", first, again)
+	}
+	if b.lockID() == first {
+		t.Errorf("two wallets share id %d", first)
+	}
+}
+
+func TestWalletHistory(t *testing.T) {
+	// each call of the fake clock is one minute after the last
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	calls := 0
+	clock := func() time.Time {
+		calls++
+		return start.Add(time.Duration(calls) * time.Minute)
+	}
+
+	wallet := NewWallet(clock)
+	wallet.Deposit(Bitcoin(50))
+	assertNoError(t, wallet.Withdraw(Bitcoin(20)))
+	// the failed withdrawal must not show up in the history
+	assertError(t, wallet.Withdraw(Bitcoin(100)), ErrInsufficientFunds)
+	wallet.Deposit(Bitcoin(5))
+
+	got := wallet.History()
+	want := []Transaction{
+		{Amount: Bitcoin(50), Kind: KindDeposit, Time: start.Add(1 * time.Minute)},
+		{Amount: Bitcoin(20), Kind: KindWithdraw, Time: start.Add(2 * time.Minute)},
+		{Amount: Bitcoin(5), Kind: KindDeposit, Time: start.Add(3 * time.Minute)},
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("got %d transactions want %d", len(got), len(want))
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v want %v", got, want)
+	}
+}
+
+func TestWalletStatement(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	calls := 0
+	clock := func() time.Time {
+		calls++
+		return start.Add(time.Duration(calls) * time.Minute)
+	}
+
+	wallet := NewWallet(clock)
+	wallet.Deposit(Bitcoin(50))
+	wallet.Withdraw(Bitcoin(20))
+	wallet.Withdraw(Bitcoin(100))
+	wallet.Deposit(Bitcoin(5))
+
+	buffer := &bytes.Buffer{}
+	wallet.Statement(buffer)
+
+	got := buffer.String()
+	want := `2024-01-01 12:01 deposit  50 BTC
+2024-01-01 12:02 withdraw 20 BTC
+2024-01-01 12:03 deposit  5 BTC
+Balance: 35 BTC
+`
+	if got != want {
+		t.Errorf("got %q want %q", got, want)
+	}
+
+	if wallet.String() != "35 BTC" {
+		t.Errorf("got %q want %q", wallet.String(), "35 BTC")
+	}
+}
+
+func assertBalance(t testing.TB, wallet *Wallet, want Bitcoin) {
 	t.Helper()
 	got := wallet.Balance()
 
@@ -1,14 +1,42 @@
 package structsmethodsinterfaces
 
 import (
+	"errors"
+	"fmt"
 	"math"
 )
 
+var ErrInvalidShape = errors.New("invalid shape")
+
 // Interface shape
 type Shape interface {
 	Area() float64
 }
 
+// Measurable is a 2D shape that knows the length of its outline
+type Measurable interface {
+	Perimeter() float64
+}
+
+// Solid is a 3D shape
+type Solid interface {
+	Volume() float64
+}
+
+// ValidatedShape is a Shape that can check its own dimensions make sense
+type ValidatedShape interface {
+	Shape
+	Validate() error
+}
+
+// SafeArea returns the area of s, or an error if s has invalid dimensions
+func SafeArea(s ValidatedShape) (float64, error) {
+	if err := s.Validate(); err != nil {
+		return 0, err
+	}
+	return s.Area(), nil
+}
+
 // Rectangle methods
 func (r *Rectangle) Perimeter() float64 {
 	return 2 * (r.Height + r.Width)
@@ -18,6 +46,13 @@ func (r *Rectangle) Area() float64 {
 	return r.Height * r.Width
 }
 
+func (r *Rectangle) Validate() error {
+	if r.Width <= 0 || r.Height <= 0 {
+		return fmt.Errorf("%w: rectangle needs a positive width and height, got %gx%g", ErrInvalidShape, r.Width, r.Height)
+	}
+	return nil
+}
+
 // Circle methods
 func (r *Circle) Perimeter() float64 {
 	return 2 * r.Radius * math.Pi
@@ -27,7 +62,43 @@ func (r *Circle) Area() float64 {
 	return math.Pi * r.Radius * r.Radius
 }
 
+func (r *Circle) Validate() error {
+	if r.Radius <= 0 {
+		return fmt.Errorf("%w: circle needs a positive radius, got %g", ErrInvalidShape, r.Radius)
+	}
+	return nil
+}
+
 // Triangle methods
+
+// Perimeter assumes a right triangle, with Base and Height as the two legs,
+// since those are the only sides the struct knows about
+func (t *Triangle) Perimeter() float64 {
+	return t.Base + t.Height + math.Hypot(t.Base, t.Height)
+}
+
 func (t *Triangle) Area() float64 {
 	return (t.Base * t.Height) / 2
-}
\ No newline at end of file
+}
+
+func (t *Triangle) Validate() error {
+	if t.Base <= 0 || t.Height <= 0 {
+		return fmt.Errorf("%w: triangle needs a positive base and height, got %g and %g", ErrInvalidShape, t.Base, t.Height)
+	}
+	return nil
+}
+
+// Cuboid methods
+func (c *Cuboid) Volume() float64 {
+	return c.Width * c.Height * c.Depth
+}
+
+// Sphere methods
+func (s *Sphere) Volume() float64 {
+	return 4 * math.Pi * s.Radius * s.Radius * s.Radius / 3
+}
+
+// Cylinder methods
+func (c *Cylinder) Volume() float64 {
+	return math.Pi * c.Radius * c.Radius * c.Height
+}
@@ -1,15 +1,28 @@
 package structsmethodsinterfaces
 
 import (
+	"errors"
 	"testing"
 )
 
 func TestPerimeter(t *testing.T) {
-	rectangle := Rectangle{10.0, 10.0}
-	got := rectangle.Perimeter()
-	want := 40.0
-	if got != want {
-		t.Errorf("got %.2f want %.2f", got, want)
+	perimeterTests := []struct {
+		name  string
+		shape Measurable
+		want  float64
+	}{
+		{name: "Rectangle", shape: &Rectangle{10.0, 10.0}, want: 40.0},
+		{name: "Circle", shape: &Circle{10}, want: 62.83185307179586},
+		{name: "Triangle", shape: &Triangle{3.0, 4.0}, want: 12.0},
+	}
+
+	for _, tt := range perimeterTests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.shape.Perimeter()
+			if got != tt.want {
+				t.Errorf("%#v got %g want %g", tt.shape, got, tt.want)
+			}
+		})
 	}
 }
 
@@ -53,3 +66,66 @@ func TestArea(t *testing.T) {
 		})
 	}
 }
+
+func TestVolume(t *testing.T) {
+	volumeTests := []struct {
+		name  string
+		solid Solid
+		want  float64
+	}{
+		{name: "Cuboid", solid: &Cuboid{Width: 2.0, Height: 3.0, Depth: 4.0}, want: 24.0},
+		{name: "Sphere", solid: &Sphere{Radius: 3.0}, want: 113.09733552923255},
+		{name: "Cylinder", solid: &Cylinder{Radius: 2.0, Height: 5.0}, want: 62.83185307179586},
+	}
+
+	for _, tt := range volumeTests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.solid.Volume()
+			if got != tt.want {
+				t.Errorf("%#v got %g want %g", tt.solid, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSafeArea(t *testing.T) {
+	validTests := []struct {
+		name  string
+		shape ValidatedShape
+		want  float64
+	}{
+		{name: "Rectangle", shape: &Rectangle{10.0, 5.0}, want: 50.0},
+		{name: "Circle", shape: &Circle{10}, want: 314.1592653589793},
+		{name: "Triangle", shape: &Triangle{10.0, 5.0}, want: 25.0},
+	}
+
+	for _, tt := range validTests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SafeArea(tt.shape)
+			if err != nil {
+				t.Fatalf("did not expect an error but got one %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("%#v got %g want %g", tt.shape, got, tt.want)
+			}
+		})
+	}
+
+	invalidTests := []struct {
+		name  string
+		shape ValidatedShape
+	}{
+		{name: "Circle with negative radius", shape: &Circle{-1}},
+		{name: "Rectangle with zero width", shape: &Rectangle{0, 5.0}},
+		{name: "Triangle with negative height", shape: &Triangle{3.0, -2.0}},
+	}
+
+	for _, tt := range invalidTests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := SafeArea(tt.shape)
+			if !errors.Is(err, ErrInvalidShape) {
+				t.Errorf("%#v got error %v want %v", tt.shape, err, ErrInvalidShape)
+			}
+		})
+	}
+}
@@ -12,4 +12,19 @@ type Circle struct {
 type Triangle struct {
 	Base float64
 	Height float64
-}
\ No newline at end of file
+}
+
+type Cuboid struct {
+	Width  float64
+	Height float64
+	Depth  float64
+}
+
+type Sphere struct {
+	Radius float64
+}
+
+type Cylinder struct {
+	Radius float64
+	Height float64
+}
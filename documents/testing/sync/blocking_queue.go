@@ -0,0 +1,90 @@
+package sync
+
+import (
+	"fmt"
+	"sync"
+)
+
+// BlockingQueue is a fixed capacity first in first out queue that is safe for
+// concurrent use. Put waits while the queue is full and Take waits while it is
+// empty, which makes it a building block for producer consumer pipelines.
+type BlockingQueue[T any] struct {
+	mu       sync.Mutex
+	notEmpty *sync.Cond
+	notFull  *sync.Cond
+
+	// items is a ring buffer, head is the front and count how many are queued
+	items  []T
+	head   int
+	count  int
+	closed bool
+}
+
+// NewBlockingQueue creates a BlockingQueue holding at most capacity items.
+// It panics if capacity is less than 1.
+func NewBlockingQueue[T any](capacity int) *BlockingQueue[T] {
+	if capacity < 1 {
+		panic(fmt.Sprintf("sync: BlockingQueue capacity must be at least 1, got %d", capacity))
+	}
+
+	q := &BlockingQueue[T]{items: make([]T, capacity)}
+	q.notEmpty = sync.NewCond(&q.mu)
+	q.notFull = sync.NewCond(&q.mu)
+	return q
+}
+
+// Put adds v to the back of the queue, waiting for room if it is full, and reports
+// whether v was added. It returns false without adding v if the queue is closed,
+// including when Close is called while Put is waiting, so producers can stop
+// cleanly during shutdown.
+func (q *BlockingQueue[T]) Put(v T) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for q.count == len(q.items) && !q.closed {
+		q.notFull.Wait()
+	}
+	if q.closed {
+		return false
+	}
+
+	q.items[(q.head+q.count)%len(q.items)] = v
+	q.count++
+	q.notEmpty.Signal()
+	return true
+}
+
+// Take removes and returns the front item, waiting for one if the queue is empty.
+// Once the queue is closed, items already queued are still returned and then
+// Take returns the zero value and false.
+func (q *BlockingQueue[T]) Take() (T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for q.count == 0 && !q.closed {
+		q.notEmpty.Wait()
+	}
+
+	var zero T
+	if q.count == 0 {
+		return zero, false
+	}
+
+	v := q.items[q.head]
+	q.items[q.head] = zero
+	q.head = (q.head + 1) % len(q.items)
+	q.count--
+	q.notFull.Signal()
+	return v, true
+}
+
+// Close marks the queue as closed and wakes every goroutine waiting in Put or Take.
+// Closing an already closed queue does nothing.
+func (q *BlockingQueue[T]) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.closed = true
+	q.notEmpty.Broadcast()
+	q.notFull.Broadcast()
+}
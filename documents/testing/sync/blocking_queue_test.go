@@ -0,0 +1,130 @@
+package sync
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBlockingQueue(t *testing.T) {
+	t.Run("transfers every item in order", func(t *testing.T) {
+		const items = 1000
+		// a small capacity makes the producer block on a full queue many times
+		q := NewBlockingQueue[int](4)
+
+		go func() {
+			for i := 0; i < items; i++ {
+				q.Put(i)
+			}
+			q.Close()
+		}()
+
+		want := 0
+		for {
+			got, ok := q.Take()
+			if !ok {
+				break
+			}
+			if got != want {
+				t.Fatalf("got %d want %d", got, want)
+			}
+			want++
+		}
+		if want != items {
+			t.Errorf("took %d items want %d", want, items)
+		}
+	})
+
+	t.Run("close stops waiting consumers", func(t *testing.T) {
+		q := NewBlockingQueue[string](2)
+		const consumers = 5
+
+		var wg sync.WaitGroup
+		wg.Add(consumers)
+		for i := 0; i < consumers; i++ {
+			go func() {
+				defer wg.Done()
+				for {
+					if _, ok := q.Take(); !ok {
+						return
+					}
+				}
+			}()
+		}
+
+		q.Put("a")
+		q.Put("b")
+		q.Close()
+
+		done := make(chan struct{})
+		go func() {
+			wg.Wait()
+			close(done)
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("consumers were still blocked after Close")
+		}
+	})
+
+	t.Run("queued items are still taken after close", func(t *testing.T) {
+		q := NewBlockingQueue[int](3)
+		q.Put(1)
+		q.Put(2)
+		q.Close()
+
+		for _, want := range []int{1, 2} {
+			if got, ok := q.Take(); !ok || got != want {
+				t.Errorf("got %d, %t want %d, true", got, ok, want)
+			}
+		}
+		if _, ok := q.Take(); ok {
+			t.Error("expected Take on a closed empty queue to return false")
+		}
+	})
+
+	t.Run("put on a closed queue reports false", func(t *testing.T) {
+		q := NewBlockingQueue[int](1)
+		q.Close()
+
+		if q.Put(1) {
+			t.Error("expected Put on a closed queue to return false")
+		}
+		if _, ok := q.Take(); ok {
+			t.Error("expected the rejected item not to be queued")
+		}
+	})
+
+	t.Run("close stops waiting producers", func(t *testing.T) {
+		q := NewBlockingQueue[int](1)
+		q.Put(0)
+
+		// the queue is full so this Put waits until Close wakes it
+		result := make(chan bool)
+		go func() {
+			result <- q.Put(1)
+		}()
+
+		// give the producer time to start waiting
+		time.Sleep(10 * time.Millisecond)
+		q.Close()
+
+		select {
+		case ok := <-result:
+			if ok {
+				t.Error("expected the waiting Put to return false")
+			}
+		case <-time.After(time.Second):
+			t.Fatal("producer was still blocked after Close")
+		}
+
+		if got, ok := q.Take(); !ok || got != 0 {
+			t.Errorf("got %d, %t want 0, true", got, ok)
+		}
+		if _, ok := q.Take(); ok {
+			t.Error("expected only the item queued before Close")
+		}
+	})
+}
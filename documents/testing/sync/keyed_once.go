@@ -0,0 +1,31 @@
+package sync
+
+import (
+	"sync"
+)
+
+// KeyedOnce is sync.Once for many keys: Do runs fn at most once per distinct key.
+// Different keys don't block each other, while callers for a key whose fn is
+// still running wait for it to finish, just like sync.Once.Do.
+// The zero value is ready to use. A KeyedOnce must not be copied after first use.
+type KeyedOnce[K comparable] struct {
+	mu    sync.Mutex
+	onces map[K]*sync.Once
+}
+
+// Do calls fn if and only if Do has not been called for key before
+func (k *KeyedOnce[K]) Do(key K, fn func()) {
+	k.mu.Lock()
+	if k.onces == nil {
+		k.onces = make(map[K]*sync.Once)
+	}
+	once, ok := k.onces[key]
+	if !ok {
+		once = &sync.Once{}
+		k.onces[key] = once
+	}
+	// release the map lock before running fn so other keys aren't held up by it
+	k.mu.Unlock()
+
+	once.Do(fn)
+}
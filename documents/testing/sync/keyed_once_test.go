@@ -0,0 +1,51 @@
+package sync
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestKeyedOnce(t *testing.T) {
+	t.Run("runs once per key under concurrency", func(t *testing.T) {
+		var once KeyedOnce[string]
+		keys := []string{"a", "b", "c"}
+		counts := map[string]*atomic.Int32{}
+		for _, key := range keys {
+			counts[key] = &atomic.Int32{}
+		}
+
+		const goroutines = 100
+		var wg sync.WaitGroup
+		wg.Add(goroutines)
+		for i := 0; i < goroutines; i++ {
+			go func(i int) {
+				defer wg.Done()
+				key := keys[i%len(keys)]
+				once.Do(key, func() {
+					counts[key].Add(1)
+				})
+			}(i)
+		}
+		wg.Wait()
+
+		for _, key := range keys {
+			if got := counts[key].Load(); got != 1 {
+				t.Errorf("fn for %q ran %d times want 1", key, got)
+			}
+		}
+	})
+
+	t.Run("a later call for the same key is skipped", func(t *testing.T) {
+		var once KeyedOnce[int]
+		calls := 0
+
+		once.Do(1, func() { calls++ })
+		once.Do(1, func() { calls++ })
+		once.Do(2, func() { calls++ })
+
+		if calls != 2 {
+			t.Errorf("got %d calls want 2", calls)
+		}
+	})
+}
@@ -20,5 +20,7 @@ func (c *Counter) Inc() {
 }
 
 func (c *Counter) Value() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	return c.Count
 }
@@ -39,4 +39,57 @@ func assertCounter(t testing.TB, got *Counter, want int) {
 	if got.Value() != want {
 		t.Errorf("got %d, want %d", got.Value(), want)
 	}
-}
\ No newline at end of file
+}
+
+// rwCounter is Counter guarded by a sync.RWMutex instead, so reads can share the lock.
+// It only exists to compare against Counter in BenchmarkCounterReadHeavy.
+type rwCounter struct {
+	mu    sync.RWMutex
+	count int
+}
+
+func (c *rwCounter) Inc() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.count++
+}
+
+func (c *rwCounter) Value() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.count
+}
+
+// BenchmarkCounterReadHeavy does one Inc for every 99 Value calls from many goroutines
+// at once. Compare the ns/op of the two sub-benchmarks: with a Mutex every read
+// waits its turn, while an RWMutex lets readers in together, so RWMutex should pull
+// ahead as -cpu goes up. On a single CPU, or with more writes, the extra bookkeeping
+// of RWMutex can make it the slower of the two, so measure before switching.
+func BenchmarkCounterReadHeavy(b *testing.B) {
+	counters := []struct {
+		name    string
+		counter interface {
+			Inc()
+			Value() int
+		}
+	}{
+		{"Mutex", NewCounter()},
+		{"RWMutex", &rwCounter{}},
+	}
+
+	for _, c := range counters {
+		b.Run(c.name, func(b *testing.B) {
+			b.RunParallel(func(pb *testing.PB) {
+				i := 0
+				for pb.Next() {
+					if i%100 == 0 {
+						c.counter.Inc()
+					} else {
+						c.counter.Value()
+					}
+					i++
+				}
+			})
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package graph
+
+import (
+	"errors"
+
+	"github.com/aziz-shoko/dsa-go/structures"
+)
+
+// ErrNoPath is returned when the goal can't be reached from the start
+var ErrNoPath = errors.New("no path between vertices")
+
+// AStar finds the cheapest path from start to goal and returns it along with its cost.
+// heuristic estimates the remaining cost from a vertex to goal. It must never
+// overestimate for the result to be optimal; a heuristic that always returns 0
+// turns the search into Dijkstra's algorithm. Edge weights must not be negative.
+// The heuristic doesn't have to be consistent: a vertex that was already expanded
+// is reopened when a cheaper path to it turns up, at the price of expanding it again.
+func AStar[T comparable](g WeightedGraph[T], start, goal T, heuristic func(T) int) ([]T, int, error) {
+	// cost holds the cheapest known cost from start, parent the vertex we came from on that path
+	cost := map[T]int{start: 0}
+	parent := make(map[T]T)
+	// expanded holds the cost each vertex had when its edges were last followed
+	expanded := make(map[T]int)
+
+	// the open set pops the lowest estimated total first, so priorities are negated
+	var open structures.PriorityQueue[T]
+	open.Push(start, -heuristic(start))
+
+	for open.Len() > 0 {
+		v, _ := open.Pop()
+		if c, done := expanded[v]; done && c <= cost[v] {
+			// a stale entry, v was already expanded with its cheapest known cost
+			continue
+		}
+		if v == goal {
+			return buildPath(parent, start, goal), cost[goal], nil
+		}
+		expanded[v] = cost[v]
+
+		for _, e := range g.Edges(v) {
+			next := cost[v] + e.Weight
+			if old, seen := cost[e.To]; seen && next >= old {
+				continue
+			}
+			cost[e.To] = next
+			parent[e.To] = v
+			open.Push(e.To, -(next + heuristic(e.To)))
+		}
+	}
+
+	return nil, 0, ErrNoPath
+}
+
+// buildPath walks parent links back from goal and returns the path in start to goal order
+func buildPath[T comparable](parent map[T]T, start, goal T) []T {
+	path := []T{goal}
+	for v := goal; v != start; {
+		v = parent[v]
+		path = append(path, v)
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
@@ -0,0 +1,179 @@
+package graph
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type cell struct {
+	X, Y int
+}
+
+// newGrid builds a width x height grid where moving into a cell costs its weight,
+// cells with a weight of 0 are walls and can't be entered.
+func newGrid(weights [][]int) *WeightedAdjacencyList[cell] {
+	g := NewWeighted[cell]()
+	for y, row := range weights {
+		for x := range row {
+			from := cell{x, y}
+			g.AddVertex(from)
+			for _, d := range []cell{{1, 0}, {-1, 0}, {0, 1}, {0, -1}} {
+				to := cell{x + d.X, y + d.Y}
+				if to.Y < 0 || to.Y >= len(weights) || to.X < 0 || to.X >= len(weights[to.Y]) {
+					continue
+				}
+				if w := weights[to.Y][to.X]; w > 0 {
+					g.AddEdge(from, to, w)
+				}
+			}
+		}
+	}
+	return g
+}
+
+func manhattan(goal cell) func(cell) int {
+	return func(c cell) int {
+		return abs(c.X-goal.X) + abs(c.Y-goal.Y)
+	}
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+func dijkstraHeuristic(cell) int { return 0 }
+
+func TestAStar(t *testing.T) {
+	t.Run("matches dijkstra on a weighted grid", func(t *testing.T) {
+		g := newGrid([][]int{
+			{1, 1, 1, 1, 1},
+			{1, 0, 0, 0, 1},
+			{1, 5, 9, 0, 1},
+			{1, 0, 1, 1, 1},
+			{1, 1, 1, 0, 1},
+		})
+		start, goal := cell{0, 0}, cell{2, 4}
+
+		path, cost, err := AStar[cell](g, start, goal, manhattan(goal))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		wantPath, wantCost, err := AStar[cell](g, start, goal, dijkstraHeuristic)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if cost != wantCost {
+			t.Errorf("got cost %d want %d", cost, wantCost)
+		}
+		if !reflect.DeepEqual(path, wantPath) {
+			t.Errorf("got path %v want %v", path, wantPath)
+		}
+		if cost != 6 {
+			t.Errorf("got cost %d want 6", cost)
+		}
+		assertPathCost(t, g, path, start, goal, cost)
+	})
+
+	t.Run("goes around an expensive shortcut", func(t *testing.T) {
+		g := newGrid([][]int{
+			{1, 50, 1},
+			{1, 1, 1},
+		})
+		start, goal := cell{0, 0}, cell{2, 0}
+
+		path, cost, err := AStar[cell](g, start, goal, manhattan(goal))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		want := []cell{{0, 0}, {0, 1}, {1, 1}, {2, 1}, {2, 0}}
+		if !reflect.DeepEqual(path, want) {
+			t.Errorf("got path %v want %v", path, want)
+		}
+		assertPathCost(t, g, path, start, goal, cost)
+	})
+
+	t.Run("admissible but inconsistent heuristic", func(t *testing.T) {
+		// h(a) = 4 is the true remaining cost from a so it never overestimates, but it
+		// drops by more than the edge weight from s to a. c gets expanded through the
+		// direct edge before the cheaper route through a is found, and must be reopened.
+		g := NewWeighted[string]()
+		g.AddEdge("s", "a", 1)
+		g.AddEdge("a", "c", 1)
+		g.AddEdge("s", "c", 3)
+		g.AddEdge("c", "g", 3)
+		heuristic := func(v string) int {
+			if v == "a" {
+				return 4
+			}
+			return 0
+		}
+
+		path, cost, err := AStar[string](g, "s", "g", heuristic)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		want := []string{"s", "a", "c", "g"}
+		if !reflect.DeepEqual(path, want) || cost != 5 {
+			t.Errorf("got path %v cost %d want %v cost 5", path, cost, want)
+		}
+	})
+
+	t.Run("start is goal", func(t *testing.T) {
+		g := newGrid([][]int{{1, 1}})
+
+		path, cost, err := AStar[cell](g, cell{0, 0}, cell{0, 0}, manhattan(cell{0, 0}))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(path, []cell{{0, 0}}) || cost != 0 {
+			t.Errorf("got path %v cost %d want [{0 0}] cost 0", path, cost)
+		}
+	})
+
+	t.Run("unreachable goal", func(t *testing.T) {
+		g := newGrid([][]int{
+			{1, 0, 1},
+			{1, 0, 1},
+		})
+		goal := cell{2, 0}
+
+		_, _, err := AStar[cell](g, cell{0, 0}, goal, manhattan(goal))
+		if !errors.Is(err, ErrNoPath) {
+			t.Errorf("got error %v want %v", err, ErrNoPath)
+		}
+	})
+}
+
+// assertPathCost checks path runs from start to goal along real edges whose weights sum to cost
+func assertPathCost(t testing.TB, g WeightedGraph[cell], path []cell, start, goal cell, cost int) {
+	t.Helper()
+
+	if len(path) == 0 || path[0] != start || path[len(path)-1] != goal {
+		t.Fatalf("path %v does not run from %v to %v", path, start, goal)
+	}
+
+	sum := 0
+	for i := 1; i < len(path); i++ {
+		found := false
+		for _, e := range g.Edges(path[i-1]) {
+			if e.To == path[i] {
+				sum += e.Weight
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Fatalf("no edge from %v to %v", path[i-1], path[i])
+		}
+	}
+	if sum != cost {
+		t.Errorf("path edges sum to %d but cost is %d", sum, cost)
+	}
+}
@@ -0,0 +1,31 @@
+package graph
+
+// ShortestPathBFS returns a path from start to goal with the fewest edges, found
+// with a breadth-first search, or false if goal can't be reached. When several
+// paths are equally short the one found first in Neighbors order is returned.
+func ShortestPathBFS[T comparable](g Graph[T], start, goal T) ([]T, bool) {
+	if start == goal {
+		return []T{start}, true
+	}
+
+	// parent doubles as the visited set, start has no parent but is marked seen
+	parent := map[T]T{start: start}
+	queue := []T{start}
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+
+		for _, n := range g.Neighbors(v) {
+			if _, seen := parent[n]; seen {
+				continue
+			}
+			parent[n] = v
+			if n == goal {
+				return buildPath(parent, start, goal), true
+			}
+			queue = append(queue, n)
+		}
+	}
+
+	return nil, false
+}
@@ -0,0 +1,55 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestShortestPathBFS(t *testing.T) {
+	// a -> b -> c -> d -> e is the long way round, a -> f -> e is shorter
+	g := New[string]()
+	g.AddEdge("a", "b")
+	g.AddEdge("b", "c")
+	g.AddEdge("c", "d")
+	g.AddEdge("d", "e")
+	g.AddEdge("a", "f")
+	g.AddEdge("f", "e")
+	g.AddEdge("e", "a")
+	g.AddVertex("island")
+
+	t.Run("finds the minimum hop path", func(t *testing.T) {
+		got, ok := ShortestPathBFS[string](g, "a", "e")
+		if !ok {
+			t.Fatal("expected a path")
+		}
+		want := []string{"a", "f", "e"}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got %v want %v", got, want)
+		}
+	})
+
+	t.Run("follows edge direction", func(t *testing.T) {
+		got, ok := ShortestPathBFS[string](g, "d", "b")
+		if !ok {
+			t.Fatal("expected a path")
+		}
+		want := []string{"d", "e", "a", "b"}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got %v want %v", got, want)
+		}
+	})
+
+	t.Run("start is goal", func(t *testing.T) {
+		got, ok := ShortestPathBFS[string](g, "c", "c")
+		if !ok || !reflect.DeepEqual(got, []string{"c"}) {
+			t.Errorf("got %v, %t want [c], true", got, ok)
+		}
+	})
+
+	t.Run("unreachable goal", func(t *testing.T) {
+		got, ok := ShortestPathBFS[string](g, "a", "island")
+		if ok {
+			t.Errorf("got %v, expected no path", got)
+		}
+	})
+}
@@ -0,0 +1,47 @@
+package graph
+
+// ConnectedComponents returns the vertex sets of each connected component of g,
+// treating every edge as undirected. Since T is only comparable it can't be
+// sorted by value, so the order comes from g.Vertices() instead: members of a
+// component keep that order, and components are ordered by their first member.
+func ConnectedComponents[T comparable](g Graph[T]) [][]T {
+	vertices := g.Vertices()
+
+	// build the undirected view, every edge is walkable in both directions
+	undirected := make(map[T][]T, len(vertices))
+	for _, v := range vertices {
+		for _, n := range g.Neighbors(v) {
+			undirected[v] = append(undirected[v], n)
+			undirected[n] = append(undirected[n], v)
+		}
+	}
+
+	component := make(map[T]int, len(vertices))
+	count := 0
+	for _, start := range vertices {
+		if _, seen := component[start]; seen {
+			continue
+		}
+
+		// flood fill everything reachable from start
+		component[start] = count
+		stack := []T{start}
+		for len(stack) > 0 {
+			v := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			for _, n := range undirected[v] {
+				if _, seen := component[n]; !seen {
+					component[n] = count
+					stack = append(stack, n)
+				}
+			}
+		}
+		count++
+	}
+
+	components := make([][]T, count)
+	for _, v := range vertices {
+		components[component[v]] = append(components[component[v]], v)
+	}
+	return components
+}
@@ -0,0 +1,49 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestConnectedComponents(t *testing.T) {
+	t.Run("two components and an isolated vertex", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("a", "b")
+		g.AddEdge("c", "b") // direction doesn't matter
+		g.AddEdge("x", "y")
+		g.AddEdge("z", "y")
+		g.AddVertex("lonely")
+		g.AddEdge("b", "d")
+
+		got := ConnectedComponents[string](g)
+		want := [][]string{
+			{"a", "b", "c", "d"},
+			{"x", "y", "z"},
+			{"lonely"},
+		}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got %v want %v", got, want)
+		}
+	})
+
+	t.Run("empty graph", func(t *testing.T) {
+		got := ConnectedComponents[int](New[int]())
+		if len(got) != 0 {
+			t.Errorf("got %v want no components", got)
+		}
+	})
+
+	t.Run("cycle is one component", func(t *testing.T) {
+		g := New[int]()
+		g.AddEdge(1, 2)
+		g.AddEdge(2, 3)
+		g.AddEdge(3, 1)
+
+		got := ConnectedComponents[int](g)
+		want := [][]int{{1, 2, 3}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got %v want %v", got, want)
+		}
+	})
+}
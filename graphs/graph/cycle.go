@@ -0,0 +1,58 @@
+package graph
+
+// HasCycle reports whether the directed graph g contains a cycle
+func HasCycle[T comparable](g Graph[T]) bool {
+	_, found := FindCycle(g)
+	return found
+}
+
+// FindCycle returns the vertices of one cycle in the directed graph g, if any.
+// The cycle is returned in edge order without repeating the first vertex, so
+// [a b c] means a -> b -> c -> a. A self loop is returned as a single vertex.
+//
+// It runs a depth first search keeping the current path (the recursion stack).
+// Reaching a vertex that is already on the path closes a cycle.
+func FindCycle[T comparable](g Graph[T]) ([]T, bool) {
+	const (
+		unvisited = iota
+		onPath
+		done
+	)
+	state := map[T]int{}
+	var path []T
+
+	var visit func(v T) []T
+	visit = func(v T) []T {
+		state[v] = onPath
+		path = append(path, v)
+
+		for _, n := range g.Neighbors(v) {
+			switch state[n] {
+			case onPath:
+				// the cycle is the part of the path from n onwards
+				for i := len(path) - 1; i >= 0; i-- {
+					if path[i] == n {
+						return append([]T(nil), path[i:]...)
+					}
+				}
+			case unvisited:
+				if cycle := visit(n); cycle != nil {
+					return cycle
+				}
+			}
+		}
+
+		path = path[:len(path)-1]
+		state[v] = done
+		return nil
+	}
+
+	for _, v := range g.Vertices() {
+		if state[v] == unvisited {
+			if cycle := visit(v); cycle != nil {
+				return cycle, true
+			}
+		}
+	}
+	return nil, false
+}
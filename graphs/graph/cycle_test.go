@@ -0,0 +1,82 @@
+package graph
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestFindCycle(t *testing.T) {
+	t.Run("acyclic graph", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("a", "b")
+		g.AddEdge("a", "c")
+		g.AddEdge("b", "d")
+		g.AddEdge("c", "d")
+
+		if HasCycle[string](g) {
+			t.Error("did not expect a cycle")
+		}
+		if cycle, found := FindCycle[string](g); found {
+			t.Errorf("did not expect a cycle but got %v", cycle)
+		}
+	})
+
+	t.Run("simple cycle", func(t *testing.T) {
+		g := New[string]()
+		g.AddEdge("start", "a")
+		g.AddEdge("a", "b")
+		g.AddEdge("b", "c")
+		g.AddEdge("c", "a")
+		g.AddEdge("c", "end")
+
+		if !HasCycle[string](g) {
+			t.Fatal("expected a cycle")
+		}
+		cycle, _ := FindCycle[string](g)
+		assertCycle(t, g, cycle)
+		if len(cycle) != 3 {
+			t.Errorf("got cycle %v, want the three vertices a, b and c", cycle)
+		}
+	})
+
+	t.Run("self loop", func(t *testing.T) {
+		g := New[int]()
+		g.AddEdge(1, 2)
+		g.AddEdge(2, 2)
+
+		cycle, found := FindCycle[int](g)
+		if !found {
+			t.Fatal("expected a cycle")
+		}
+		if !slices.Equal(cycle, []int{2}) {
+			t.Errorf("got cycle %v want %v", cycle, []int{2})
+		}
+		assertCycle(t, g, cycle)
+	})
+
+	t.Run("edges into an already finished vertex are not a cycle", func(t *testing.T) {
+		g := New[int]()
+		g.AddEdge(1, 2)
+		g.AddEdge(3, 2)
+		g.AddEdge(3, 1)
+
+		if HasCycle[int](g) {
+			t.Error("did not expect a cycle")
+		}
+	})
+}
+
+// assertCycle checks that every vertex in cycle has an edge to the next one and
+// the last vertex has an edge back to the first
+func assertCycle[T comparable](t testing.TB, g Graph[T], cycle []T) {
+	t.Helper()
+	if len(cycle) == 0 {
+		t.Fatal("got an empty cycle")
+	}
+	for i, v := range cycle {
+		next := cycle[(i+1)%len(cycle)]
+		if !slices.Contains(g.Neighbors(v), next) {
+			t.Errorf("cycle %v is broken, there is no edge %v -> %v", cycle, v, next)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+// package graph provides generic graph types and algorithms that run on them
+package graph
+
+// Graph is a directed, unweighted graph. Algorithms in this package only need
+// to list vertices and follow edges, so any representation can implement it.
+// Both methods must return vertices in a stable order for results to be deterministic.
+type Graph[T comparable] interface {
+	// Vertices returns every vertex in the graph
+	Vertices() []T
+	// Neighbors returns the vertices v has an edge to
+	Neighbors(v T) []T
+}
+
+// AdjacencyList is a Graph that stores, for every vertex, the list of vertices
+// it has an edge to. Vertices are reported in the order they were first added.
+type AdjacencyList[T comparable] struct {
+	order []T
+	edges map[T][]T
+}
+
+// New creates an empty AdjacencyList
+func New[T comparable]() *AdjacencyList[T] {
+	return &AdjacencyList[T]{edges: make(map[T][]T)}
+}
+
+// AddVertex adds v to the graph, adding an existing vertex does nothing
+func (g *AdjacencyList[T]) AddVertex(v T) {
+	if _, ok := g.edges[v]; ok {
+		return
+	}
+	g.order = append(g.order, v)
+	g.edges[v] = nil
+}
+
+// AddEdge adds a directed edge from -> to, adding either vertex if it is missing
+func (g *AdjacencyList[T]) AddEdge(from, to T) {
+	g.AddVertex(from)
+	g.AddVertex(to)
+	g.edges[from] = append(g.edges[from], to)
+}
+
+// Vertices returns the vertices in the order they were added
+func (g *AdjacencyList[T]) Vertices() []T {
+	return append([]T(nil), g.order...)
+}
+
+// Neighbors returns the vertices v has an edge to, in the order the edges were added
+func (g *AdjacencyList[T]) Neighbors(v T) []T {
+	return append([]T(nil), g.edges[v]...)
+}
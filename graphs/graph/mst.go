@@ -0,0 +1,51 @@
+package graph
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/aziz-shoko/dsa-go/structures/unionfind"
+)
+
+// ErrDisconnected is returned when a spanning tree can't reach every vertex
+var ErrDisconnected = errors.New("graph is not connected")
+
+// MinimumSpanningTree finds a minimum spanning tree of g using Kruskal's algorithm
+// and returns its edges along with their total weight. Edges are treated as
+// undirected, so an edge added in both directions is only considered once.
+// Edges are taken in ascending weight order, ties keep the order g reports them in.
+func MinimumSpanningTree[T comparable](g WeightedGraph[T]) ([]Edge[T], int, error) {
+	vertices := g.Vertices()
+
+	var edges []Edge[T]
+	for _, v := range vertices {
+		edges = append(edges, g.Edges(v)...)
+	}
+	sort.SliceStable(edges, func(i, j int) bool {
+		return edges[i].Weight < edges[j].Weight
+	})
+
+	sets := unionfind.New[T]()
+	for _, v := range vertices {
+		sets.Add(v)
+	}
+
+	var tree []Edge[T]
+	total := 0
+	for _, e := range edges {
+		// skip edges that would close a cycle, this also drops the reverse copy of undirected edges
+		if !sets.Union(e.From, e.To) {
+			continue
+		}
+		tree = append(tree, e)
+		total += e.Weight
+		if len(tree) == len(vertices)-1 {
+			break
+		}
+	}
+
+	if sets.Sets() > 1 {
+		return nil, 0, ErrDisconnected
+	}
+	return tree, total, nil
+}
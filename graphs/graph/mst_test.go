@@ -0,0 +1,103 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aziz-shoko/dsa-go/structures/unionfind"
+)
+
+func TestMinimumSpanningTree(t *testing.T) {
+	t.Run("classic example", func(t *testing.T) {
+		g := NewWeighted[string]()
+		g.AddUndirectedEdge("a", "b", 4)
+		g.AddUndirectedEdge("a", "h", 8)
+		g.AddUndirectedEdge("b", "c", 8)
+		g.AddUndirectedEdge("b", "h", 11)
+		g.AddUndirectedEdge("c", "d", 7)
+		g.AddUndirectedEdge("c", "f", 4)
+		g.AddUndirectedEdge("c", "i", 2)
+		g.AddUndirectedEdge("d", "e", 9)
+		g.AddUndirectedEdge("d", "f", 14)
+		g.AddUndirectedEdge("e", "f", 10)
+		g.AddUndirectedEdge("f", "g", 2)
+		g.AddUndirectedEdge("g", "h", 1)
+		g.AddUndirectedEdge("g", "i", 6)
+		g.AddUndirectedEdge("h", "i", 7)
+
+		tree, total, err := MinimumSpanningTree[string](g)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if total != 37 {
+			t.Errorf("got total weight %d want 37", total)
+		}
+		assertSpanningTree(t, g.Vertices(), tree, total)
+	})
+
+	t.Run("directed edges are treated as undirected", func(t *testing.T) {
+		g := NewWeighted[int]()
+		g.AddEdge(1, 2, 3)
+		g.AddEdge(3, 2, 1)
+		g.AddEdge(1, 3, 5)
+
+		tree, total, err := MinimumSpanningTree[int](g)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if total != 4 {
+			t.Errorf("got total weight %d want 4", total)
+		}
+		assertSpanningTree(t, g.Vertices(), tree, total)
+	})
+
+	t.Run("disconnected graph", func(t *testing.T) {
+		g := NewWeighted[int]()
+		g.AddUndirectedEdge(1, 2, 1)
+		g.AddUndirectedEdge(3, 4, 1)
+
+		_, _, err := MinimumSpanningTree[int](g)
+		if !errors.Is(err, ErrDisconnected) {
+			t.Errorf("got error %v want %v", err, ErrDisconnected)
+		}
+	})
+
+	t.Run("single vertex", func(t *testing.T) {
+		g := NewWeighted[int]()
+		g.AddVertex(1)
+
+		tree, total, err := MinimumSpanningTree[int](g)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(tree) != 0 || total != 0 {
+			t.Errorf("got %v with weight %d want an empty tree", tree, total)
+		}
+	})
+}
+
+// assertSpanningTree checks that tree has n-1 edges, no cycle, touches every vertex and sums to total
+func assertSpanningTree[T comparable](t testing.TB, vertices []T, tree []Edge[T], total int) {
+	t.Helper()
+
+	if len(tree) != len(vertices)-1 {
+		t.Fatalf("got %d edges want %d", len(tree), len(vertices)-1)
+	}
+
+	sets := unionfind.New[T]()
+	sum := 0
+	for _, e := range tree {
+		if !sets.Union(e.From, e.To) {
+			t.Errorf("edge %v closes a cycle", e)
+		}
+		sum += e.Weight
+	}
+	for _, v := range vertices {
+		if !sets.Connected(vertices[0], v) {
+			t.Errorf("vertex %v is not spanned", v)
+		}
+	}
+	if sum != total {
+		t.Errorf("edges sum to %d but total is %d", sum, total)
+	}
+}
@@ -0,0 +1,62 @@
+package graph
+
+import (
+	"errors"
+)
+
+var ErrCycle = errors.New("graph contains a cycle")
+
+// TopologicalLevels groups the vertices of a directed acyclic graph into levels.
+// An edge from -> to means to depends on from. Level 0 holds the vertices with no
+// dependencies and every later level holds the vertices whose dependencies are all
+// in earlier levels, so the vertices within one level can be processed in parallel.
+// Within a level vertices keep the order of g.Vertices().
+//
+// It is Kahn's algorithm run one level at a time. A graph with a cycle returns ErrCycle.
+func TopologicalLevels[T comparable](g Graph[T]) ([][]T, error) {
+	vertices := g.Vertices()
+
+	inDegree := make(map[T]int, len(vertices))
+	for _, v := range vertices {
+		for _, n := range g.Neighbors(v) {
+			inDegree[n]++
+		}
+	}
+
+	var current []T
+	for _, v := range vertices {
+		if inDegree[v] == 0 {
+			current = append(current, v)
+		}
+	}
+
+	var levels [][]T
+	placed := 0
+	for len(current) > 0 {
+		levels = append(levels, current)
+		placed += len(current)
+
+		ready := map[T]bool{}
+		for _, v := range current {
+			for _, n := range g.Neighbors(v) {
+				inDegree[n]--
+				if inDegree[n] == 0 {
+					ready[n] = true
+				}
+			}
+		}
+
+		var next []T
+		for _, v := range vertices {
+			if ready[v] {
+				next = append(next, v)
+			}
+		}
+		current = next
+	}
+
+	if placed != len(vertices) {
+		return nil, ErrCycle
+	}
+	return levels, nil
+}
@@ -0,0 +1,82 @@
+package graph
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestTopologicalLevels(t *testing.T) {
+	t.Run("groups a DAG into levels", func(t *testing.T) {
+		// a build: compile needs fetch and generate, test and lint need compile,
+		// release needs test and lint
+		g := New[string]()
+		g.AddEdge("fetch", "compile")
+		g.AddEdge("generate", "compile")
+		g.AddEdge("compile", "test")
+		g.AddEdge("compile", "lint")
+		g.AddEdge("test", "release")
+		g.AddEdge("lint", "release")
+		g.AddVertex("docs")
+
+		got, err := TopologicalLevels[string](g)
+		if err != nil {
+			t.Fatalf("did not expect an error but got one %v", err)
+		}
+
+		want := [][]string{
+			{"fetch", "generate", "docs"},
+			{"compile"},
+			{"test", "lint"},
+			{"release"},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got %v want %v", got, want)
+		}
+
+		assertLevelsIndependent(t, g, got)
+	})
+
+	t.Run("empty graph", func(t *testing.T) {
+		got, err := TopologicalLevels[int](New[int]())
+		if err != nil {
+			t.Fatalf("did not expect an error but got one %v", err)
+		}
+		if len(got) != 0 {
+			t.Errorf("got %v want no levels", got)
+		}
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		g := New[int]()
+		g.AddEdge(1, 2)
+		g.AddEdge(2, 3)
+		g.AddEdge(3, 1)
+		g.AddEdge(0, 1)
+
+		_, err := TopologicalLevels[int](g)
+		if !errors.Is(err, ErrCycle) {
+			t.Errorf("got error %v want %v", err, ErrCycle)
+		}
+	})
+}
+
+// assertLevelsIndependent checks that no edge connects two vertices in the same
+// level and that every edge points to a later level
+func assertLevelsIndependent[T comparable](t testing.TB, g Graph[T], levels [][]T) {
+	t.Helper()
+	levelOf := map[T]int{}
+	for i, level := range levels {
+		for _, v := range level {
+			levelOf[v] = i
+		}
+	}
+
+	for _, v := range g.Vertices() {
+		for _, n := range g.Neighbors(v) {
+			if levelOf[n] <= levelOf[v] {
+				t.Errorf("edge %v -> %v goes from level %d to level %d", v, n, levelOf[v], levelOf[n])
+			}
+		}
+	}
+}
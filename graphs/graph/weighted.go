@@ -0,0 +1,70 @@
+package graph
+
+// Edge is a weighted edge from one vertex to another
+type Edge[T comparable] struct {
+	From   T
+	To     T
+	Weight int
+}
+
+// WeightedGraph is a directed graph whose edges carry an integer weight.
+// Like Graph, both methods must return results in a stable order.
+type WeightedGraph[T comparable] interface {
+	// Vertices returns every vertex in the graph
+	Vertices() []T
+	// Edges returns the edges leaving v
+	Edges(v T) []Edge[T]
+}
+
+// WeightedAdjacencyList is a WeightedGraph that stores the outgoing edges of
+// every vertex. It also satisfies Graph, so the unweighted algorithms run on it too.
+type WeightedAdjacencyList[T comparable] struct {
+	order []T
+	edges map[T][]Edge[T]
+}
+
+// NewWeighted creates an empty WeightedAdjacencyList
+func NewWeighted[T comparable]() *WeightedAdjacencyList[T] {
+	return &WeightedAdjacencyList[T]{edges: make(map[T][]Edge[T])}
+}
+
+// AddVertex adds v to the graph, adding an existing vertex does nothing
+func (g *WeightedAdjacencyList[T]) AddVertex(v T) {
+	if _, ok := g.edges[v]; ok {
+		return
+	}
+	g.order = append(g.order, v)
+	g.edges[v] = nil
+}
+
+// AddEdge adds a directed edge from -> to with the given weight, adding either vertex if it is missing
+func (g *WeightedAdjacencyList[T]) AddEdge(from, to T, weight int) {
+	g.AddVertex(from)
+	g.AddVertex(to)
+	g.edges[from] = append(g.edges[from], Edge[T]{From: from, To: to, Weight: weight})
+}
+
+// AddUndirectedEdge adds an edge in both directions with the same weight
+func (g *WeightedAdjacencyList[T]) AddUndirectedEdge(a, b T, weight int) {
+	g.AddEdge(a, b, weight)
+	g.AddEdge(b, a, weight)
+}
+
+// Vertices returns the vertices in the order they were added
+func (g *WeightedAdjacencyList[T]) Vertices() []T {
+	return append([]T(nil), g.order...)
+}
+
+// Edges returns the edges leaving v, in the order they were added
+func (g *WeightedAdjacencyList[T]) Edges(v T) []Edge[T] {
+	return append([]Edge[T](nil), g.edges[v]...)
+}
+
+// Neighbors returns the vertices v has an edge to, in the order the edges were added
+func (g *WeightedAdjacencyList[T]) Neighbors(v T) []T {
+	var neighbors []T
+	for _, e := range g.edges[v] {
+		neighbors = append(neighbors, e.To)
+	}
+	return neighbors
+}
@@ -0,0 +1,83 @@
+// package compression provides simple text compression schemes
+package compression
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var ErrMalformed = errors.New("malformed run-length encoding")
+
+const escape = '\\'
+
+// maxDecodedLen caps how many characters Decode will produce, so a huge count
+// in a short input is reported as malformed instead of exhausting memory
+const maxDecodedLen = 1 << 24
+
+// Encode run-length encodes s as a sequence of character/count pairs, e.g. "aaab" becomes "a3b1".
+// Digits and backslashes in s are escaped with a backslash ("111" becomes `\13`) so that
+// counts can't be confused with characters and Decode(Encode(s)) returns s for any valid UTF-8 s.
+func Encode(s string) string {
+	var encoded strings.Builder
+	runes := []rune(s)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		count := 1
+		for i+count < len(runes) && runes[i+count] == r {
+			count++
+		}
+
+		if unicode.IsDigit(r) || r == escape {
+			encoded.WriteRune(escape)
+		}
+		encoded.WriteRune(r)
+		encoded.WriteString(strconv.Itoa(count))
+		i += count
+	}
+
+	return encoded.String()
+}
+
+// Decode reverses Encode. It returns an error wrapping ErrMalformed when a
+// character is missing its count, a count is zero, the input ends after an escape,
+// or the decoded text would be longer than maxDecodedLen characters.
+func Decode(s string) (string, error) {
+	var decoded strings.Builder
+	runes := []rune(s)
+	total := 0
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		if r == escape {
+			i++
+			if i == len(runes) {
+				return "", fmt.Errorf("%w: input ends with an escape", ErrMalformed)
+			}
+			r = runes[i]
+		} else if unicode.IsDigit(r) {
+			return "", fmt.Errorf("%w: expected a character at position %d but got digit %q", ErrMalformed, i, r)
+		}
+		i++
+
+		start := i
+		for i < len(runes) && unicode.IsDigit(runes[i]) {
+			i++
+		}
+		count, err := strconv.Atoi(string(runes[start:i]))
+		if err != nil || count == 0 {
+			return "", fmt.Errorf("%w: invalid count for %q at position %d", ErrMalformed, r, start)
+		}
+		if count > maxDecodedLen-total {
+			return "", fmt.Errorf("%w: count %d for %q at position %d exceeds the decoded length limit", ErrMalformed, count, r, start)
+		}
+		total += count
+
+		decoded.WriteString(strings.Repeat(string(r), count))
+	}
+
+	return decoded.String(), nil
+}
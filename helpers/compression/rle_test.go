@@ -0,0 +1,75 @@
+package compression
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"testing/quick"
+)
+
+func TestEncode(t *testing.T) {
+	cases := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"empty", "", ""},
+		{"repeated runs", "aaab", "a3b1"},
+		{"single characters", "abc", "a1b1c1"},
+		{"long run", "wwwwwwwwwwww", "w12"},
+		{"unicode", "ééx", "é2x1"},
+		{"digits are escaped", "1112", `\13\21`},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Encode(tt.input)
+			if got != tt.want {
+				t.Errorf("got %q want %q", got, tt.want)
+			}
+
+			decoded, err := Decode(got)
+			if err != nil {
+				t.Fatalf("did not expect an error but got one %v", err)
+			}
+			if decoded != tt.input {
+				t.Errorf("round trip got %q want %q", decoded, tt.input)
+			}
+		})
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	cases := []struct {
+		name  string
+		input string
+	}{
+		{"missing count", "ab2"},
+		{"starts with a count", "3a"},
+		{"zero count", "a0"},
+		{"trailing escape", `a1\`},
+		{"count too large for an int", "a99999999999999999999"},
+		{"count larger than the limit", "a9223372036854775807"},
+		{"counts adding up past the limit", "a" + strconv.Itoa(maxDecodedLen) + "b1"},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Decode(tt.input)
+			if !errors.Is(err, ErrMalformed) {
+				t.Errorf("got error %v want %v", err, ErrMalformed)
+			}
+		})
+	}
+}
+
+func TestPropertiesOfRoundTrip(t *testing.T) {
+	assertion := func(s string) bool {
+		decoded, err := Decode(Encode(s))
+		return err == nil && decoded == s
+	}
+
+	if err := quick.Check(assertion, nil); err != nil {
+		t.Error("failed checks", err)
+	}
+}
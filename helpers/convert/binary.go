@@ -0,0 +1,82 @@
+// package convert provides number base conversions for teaching purposes
+package convert
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+var (
+	ErrEmpty        = errors.New("empty binary string")
+	ErrInvalidDigit = errors.New("invalid binary digit")
+	ErrOverflow     = errors.New("binary value overflows int")
+)
+
+// ToBinary returns the base 2 representation of n.
+//
+// Negative numbers use a sign prefix rather than two's complement: the magnitude
+// is written in binary and a "-" is put in front, so -5 is "-101". This mirrors
+// how we write negative decimals and keeps the output independent of int size.
+func ToBinary(n int) string {
+	if n == 0 {
+		return "0"
+	}
+
+	negative := n < 0
+	// work on the unsigned magnitude so math.MinInt doesn't overflow when negated
+	magnitude := uint64(n)
+	if negative {
+		magnitude = -magnitude
+	}
+
+	var digits []byte
+	for magnitude > 0 {
+		digits = append(digits, byte('0'+magnitude%2))
+		magnitude /= 2
+	}
+
+	var b strings.Builder
+	if negative {
+		b.WriteByte('-')
+	}
+	for i := len(digits) - 1; i >= 0; i-- {
+		b.WriteByte(digits[i])
+	}
+	return b.String()
+}
+
+// FromBinary parses a string produced by ToBinary: binary digits with an
+// optional leading "-". Any other character is an error.
+func FromBinary(s string) (int, error) {
+	digits, negative := strings.CutPrefix(s, "-")
+	if digits == "" {
+		return 0, ErrEmpty
+	}
+
+	// the largest magnitude allowed, one more for negatives so math.MinInt fits
+	limit := uint64(math.MaxInt)
+	if negative {
+		limit++
+	}
+
+	var magnitude uint64
+	for i, d := range digits {
+		if d != '0' && d != '1' {
+			return 0, fmt.Errorf("%w %q at position %d in %q", ErrInvalidDigit, d, i, s)
+		}
+		if magnitude > limit/2 {
+			return 0, fmt.Errorf("%w: %q", ErrOverflow, s)
+		}
+		magnitude = magnitude*2 + uint64(d-'0')
+		if magnitude > limit {
+			return 0, fmt.Errorf("%w: %q", ErrOverflow, s)
+		}
+	}
+
+	if negative {
+		return int(-magnitude), nil
+	}
+	return int(magnitude), nil
+}
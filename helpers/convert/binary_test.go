@@ -0,0 +1,73 @@
+package convert
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestToBinary(t *testing.T) {
+	cases := []struct {
+		decimal int
+		binary  string
+	}{
+		{0, "0"},
+		{1, "1"},
+		{2, "10"},
+		{5, "101"},
+		{255, "11111111"},
+		{-5, "-101"},
+		{-1, "-1"},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.binary, func(t *testing.T) {
+			got := ToBinary(tt.decimal)
+			if got != tt.binary {
+				t.Errorf("ToBinary(%d) got %q want %q", tt.decimal, got, tt.binary)
+			}
+
+			back, err := FromBinary(got)
+			if err != nil {
+				t.Fatalf("did not expect an error but got one %v", err)
+			}
+			if back != tt.decimal {
+				t.Errorf("round trip got %d want %d", back, tt.decimal)
+			}
+		})
+	}
+}
+
+func TestRoundTripLimits(t *testing.T) {
+	for _, n := range []int{math.MaxInt, math.MinInt} {
+		got, err := FromBinary(ToBinary(n))
+		if err != nil {
+			t.Fatalf("did not expect an error but got one %v", err)
+		}
+		if got != n {
+			t.Errorf("round trip got %d want %d", got, n)
+		}
+	}
+}
+
+func TestFromBinaryErrors(t *testing.T) {
+	cases := []struct {
+		name  string
+		input string
+		want  error
+	}{
+		{"non binary digit", "1021", ErrInvalidDigit},
+		{"empty", "", ErrEmpty},
+		{"only a sign", "-", ErrEmpty},
+		{"too many bits", "1" + ToBinary(math.MaxInt), ErrOverflow},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := FromBinary(tt.input)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("got error %v want %v", err, tt.want)
+			}
+		})
+	}
+}
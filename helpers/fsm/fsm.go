@@ -0,0 +1,51 @@
+// package fsm provides a small generic finite state machine
+package fsm
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrInvalidTransition = errors.New("no transition defined")
+
+type transition[S comparable, E comparable] struct {
+	from  S
+	event E
+}
+
+// Machine moves between states of type S in response to events of type E.
+// Transitions have to be registered up front with AddTransition.
+type Machine[S comparable, E comparable] struct {
+	current     S
+	transitions map[transition[S, E]]S
+}
+
+// NewMachine creates a Machine starting in the initial state
+func NewMachine[S comparable, E comparable](initial S) *Machine[S, E] {
+	return &Machine[S, E]{
+		current:     initial,
+		transitions: make(map[transition[S, E]]S),
+	}
+}
+
+// AddTransition registers that firing event while in state from moves the machine to state to.
+// Registering the same from/event pair again replaces the earlier target.
+func (m *Machine[S, E]) AddTransition(from S, event E, to S) {
+	m.transitions[transition[S, E]{from, event}] = to
+}
+
+// Fire applies event to the current state. If no transition is registered for it
+// the state is left unchanged and an error wrapping ErrInvalidTransition is returned.
+func (m *Machine[S, E]) Fire(event E) error {
+	to, ok := m.transitions[transition[S, E]{m.current, event}]
+	if !ok {
+		return fmt.Errorf("%w from state %v on event %v", ErrInvalidTransition, m.current, event)
+	}
+	m.current = to
+	return nil
+}
+
+// Current returns the state the machine is in
+func (m *Machine[S, E]) Current() S {
+	return m.current
+}
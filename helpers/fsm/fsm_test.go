@@ -0,0 +1,57 @@
+package fsm
+
+import (
+	"errors"
+	"testing"
+)
+
+type light string
+type signal string
+
+const (
+	red    light = "red"
+	green  light = "green"
+	yellow light = "yellow"
+
+	next      signal = "next"
+	emergency signal = "emergency"
+)
+
+func newTrafficLight() *Machine[light, signal] {
+	m := NewMachine[light, signal](red)
+	m.AddTransition(red, next, green)
+	m.AddTransition(green, next, yellow)
+	m.AddTransition(yellow, next, red)
+	return m
+}
+
+func TestMachine(t *testing.T) {
+	t.Run("valid transitions advance the state", func(t *testing.T) {
+		m := newTrafficLight()
+
+		for _, want := range []light{green, yellow, red, green} {
+			if err := m.Fire(next); err != nil {
+				t.Fatalf("did not expect an error but got one %v", err)
+			}
+			assertState(t, m, want)
+		}
+	})
+
+	t.Run("invalid event errors without changing state", func(t *testing.T) {
+		m := newTrafficLight()
+
+		err := m.Fire(emergency)
+
+		if !errors.Is(err, ErrInvalidTransition) {
+			t.Errorf("got error %v want %v", err, ErrInvalidTransition)
+		}
+		assertState(t, m, red)
+	})
+}
+
+func assertState(t testing.TB, m *Machine[light, signal], want light) {
+	t.Helper()
+	if got := m.Current(); got != want {
+		t.Errorf("got state %q want %q", got, want)
+	}
+}
@@ -0,0 +1,80 @@
+// package maputil provides generic helpers for working with maps
+package maputil
+
+import (
+	"cmp"
+	"reflect"
+	"slices"
+
+	"golang.org/x/exp/constraints"
+)
+
+// SortedKeys returns the keys of m in ascending order, for iterating over a map
+// deterministically. An empty map gives an empty, non-nil slice.
+func SortedKeys[K constraints.Ordered, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// MergeMaps returns a new map holding every entry of dst and src; neither input
+// is modified. When a key is in both, resolve is called with the dst value and the
+// src value and its result is kept. A nil resolve lets the src value overwrite.
+func MergeMaps[K comparable, V any](dst, src map[K]V, resolve func(old, new V) V) map[K]V {
+	merged := make(map[K]V, len(dst)+len(src))
+	for k, v := range dst {
+		merged[k] = v
+	}
+
+	for k, v := range src {
+		if old, ok := merged[k]; ok && resolve != nil {
+			v = resolve(old, v)
+		}
+		merged[k] = v
+	}
+	return merged
+}
+
+// Invert builds a reverse index of m, mapping each value to every key that had it.
+// When K is an integer, float or string type, including named ones, each slice of
+// keys is sorted. Other key types can't be ordered and are left in map order.
+func Invert[K, V comparable](m map[K]V) map[V][]K {
+	inverted := make(map[V][]K)
+	for k, v := range m {
+		inverted[v] = append(inverted[v], k)
+	}
+
+	for _, keys := range inverted {
+		sortIfOrdered(keys)
+	}
+	return inverted
+}
+
+// sortIfOrdered sorts keys when their kind has a natural order. K is only known
+// to be comparable, so reflection is the only way to tell whether it is ordered.
+func sortIfOrdered[K comparable](keys []K) {
+	if len(keys) < 2 {
+		return
+	}
+
+	var compare func(a, b reflect.Value) int
+	switch reflect.ValueOf(keys[0]).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		compare = func(a, b reflect.Value) int { return cmp.Compare(a.Int(), b.Int()) }
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		compare = func(a, b reflect.Value) int { return cmp.Compare(a.Uint(), b.Uint()) }
+	case reflect.Float32, reflect.Float64:
+		compare = func(a, b reflect.Value) int { return cmp.Compare(a.Float(), b.Float()) }
+	case reflect.String:
+		compare = func(a, b reflect.Value) int { return cmp.Compare(a.String(), b.String()) }
+	default:
+		return
+	}
+
+	slices.SortFunc(keys, func(a, b K) int {
+		return compare(reflect.ValueOf(a), reflect.ValueOf(b))
+	})
+}
@@ -0,0 +1,124 @@
+package maputil
+
+import (
+	"maps"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestSortedKeys(t *testing.T) {
+	t.Run("int keys", func(t *testing.T) {
+		got := SortedKeys(map[int]bool{3: true, -1: false, 10: true, 0: true})
+		want := []int{-1, 0, 3, 10}
+		if !slices.Equal(got, want) {
+			t.Errorf("got %v want %v", got, want)
+		}
+	})
+
+	t.Run("string keys", func(t *testing.T) {
+		got := SortedKeys(map[string]int{"pear": 1, "apple": 2, "fig": 3})
+		want := []string{"apple", "fig", "pear"}
+		if !slices.Equal(got, want) {
+			t.Errorf("got %v want %v", got, want)
+		}
+	})
+
+	t.Run("empty map gives a non nil slice", func(t *testing.T) {
+		got := SortedKeys(map[string]int{})
+		if got == nil || len(got) != 0 {
+			t.Errorf("got %#v want an empty non nil slice", got)
+		}
+	})
+}
+
+func TestMergeMaps(t *testing.T) {
+	t.Run("disjoint maps", func(t *testing.T) {
+		dst := map[string]int{"a": 1}
+		src := map[string]int{"b": 2}
+
+		got := MergeMaps(dst, src, nil)
+
+		assertMap(t, got, map[string]int{"a": 1, "b": 2})
+		// the inputs are left alone
+		assertMap(t, dst, map[string]int{"a": 1})
+	})
+
+	t.Run("overlapping keys keep the larger value", func(t *testing.T) {
+		dst := map[string]int{"a": 5, "b": 1, "c": 3}
+		src := map[string]int{"a": 2, "b": 4, "d": 7}
+		keepLarger := func(old, new int) int {
+			return max(old, new)
+		}
+
+		got := MergeMaps(dst, src, keepLarger)
+
+		assertMap(t, got, map[string]int{"a": 5, "b": 4, "c": 3, "d": 7})
+	})
+
+	t.Run("nil resolver overwrites", func(t *testing.T) {
+		dst := map[string]string{"go": "old definition", "rust": "kept"}
+		src := map[string]string{"go": "new definition"}
+
+		got := MergeMaps(dst, src, nil)
+
+		assertMap(t, got, map[string]string{"go": "new definition", "rust": "kept"})
+	})
+}
+
+func assertMap[K, V comparable](t testing.TB, got, want map[K]V) {
+	t.Helper()
+	if !maps.Equal(got, want) {
+		t.Errorf("got %v want %v", got, want)
+	}
+}
+
+func TestInvert(t *testing.T) {
+	t.Run("unique values", func(t *testing.T) {
+		got := Invert(map[string]int{"one": 1, "two": 2, "three": 3})
+		want := map[int][]string{1: {"one"}, 2: {"two"}, 3: {"three"}}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got %v want %v", got, want)
+		}
+	})
+
+	t.Run("duplicate values group sorted keys", func(t *testing.T) {
+		grades := map[string]string{
+			"zoe":  "A",
+			"adam": "B",
+			"mia":  "A",
+			"liam": "B",
+			"ava":  "A",
+			"noah": "C",
+		}
+
+		got := Invert(grades)
+		want := map[string][]string{
+			"A": {"ava", "mia", "zoe"},
+			"B": {"adam", "liam"},
+			"C": {"noah"},
+		}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got %v want %v", got, want)
+		}
+	})
+
+	t.Run("named integer keys are sorted", func(t *testing.T) {
+		type id int
+		got := Invert(map[id]bool{30: true, 10: true, 20: false, 5: true})
+		want := map[bool][]id{true: {5, 10, 30}, false: {20}}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got %v want %v", got, want)
+		}
+	})
+
+	t.Run("empty map", func(t *testing.T) {
+		got := Invert(map[string]int{})
+		if len(got) != 0 {
+			t.Errorf("got %v want an empty map", got)
+		}
+	})
+}
@@ -0,0 +1,37 @@
+// package memo provides memoization tables for dynamic programming
+package memo
+
+type key2[A, B comparable] struct {
+	a A
+	b B
+}
+
+// Memo2 caches results keyed by two values, the usual shape of a DP subproblem
+// like dp(i, j). It is not safe for concurrent use.
+type Memo2[A, B comparable, R any] struct {
+	table map[key2[A, B]]R
+}
+
+// NewMemo2 creates an empty Memo2
+func NewMemo2[A, B comparable, R any]() *Memo2[A, B, R] {
+	return &Memo2[A, B, R]{table: make(map[key2[A, B]]R)}
+}
+
+// Get returns the cached result for (a, b), calling compute to fill it in on
+// the first request. compute may itself call Get for other keys, which is how
+// recursive DP solutions are written.
+func (m *Memo2[A, B, R]) Get(a A, b B, compute func() R) R {
+	k := key2[A, B]{a, b}
+	if r, ok := m.table[k]; ok {
+		return r
+	}
+
+	r := compute()
+	m.table[k] = r
+	return r
+}
+
+// Len returns the number of cached results
+func (m *Memo2[A, B, R]) Len() int {
+	return len(m.table)
+}
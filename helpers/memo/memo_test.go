@@ -0,0 +1,81 @@
+package memo
+
+import (
+	"testing"
+)
+
+// editDistance solves the classic Levenshtein problem top down, counting how
+// many times each subproblem is actually computed
+func editDistance(a, b string, computed map[[2]int]int) int {
+	m := NewMemo2[int, int, int]()
+
+	var dp func(i, j int) int
+	dp = func(i, j int) int {
+		return m.Get(i, j, func() int {
+			computed[[2]int{i, j}]++
+
+			if i == 0 {
+				return j
+			}
+			if j == 0 {
+				return i
+			}
+			if a[i-1] == b[j-1] {
+				return dp(i-1, j-1)
+			}
+			return 1 + min(dp(i-1, j), dp(i, j-1), dp(i-1, j-1))
+		})
+	}
+
+	return dp(len(a), len(b))
+}
+
+func TestMemo2(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want int
+	}{
+		{"kitten", "sitting", 3},
+		{"flaw", "lawn", 2},
+		{"", "abc", 3},
+		{"same", "same", 0},
+		{"intention", "execution", 5},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.a+"/"+tt.b, func(t *testing.T) {
+			computed := map[[2]int]int{}
+
+			got := editDistance(tt.a, tt.b, computed)
+			if got != tt.want {
+				t.Errorf("got %d want %d", got, tt.want)
+			}
+
+			for subproblem, count := range computed {
+				if count != 1 {
+					t.Errorf("subproblem %v computed %d times, want once", subproblem, count)
+				}
+			}
+		})
+	}
+}
+
+func TestMemo2CachesResults(t *testing.T) {
+	m := NewMemo2[string, int, string]()
+	calls := 0
+	compute := func() string {
+		calls++
+		return "result"
+	}
+
+	m.Get("a", 1, compute)
+	m.Get("a", 1, compute)
+	m.Get("a", 2, compute)
+
+	if calls != 2 {
+		t.Errorf("got %d computations want %d", calls, 2)
+	}
+	if m.Len() != 2 {
+		t.Errorf("got %d cached results want %d", m.Len(), 2)
+	}
+}
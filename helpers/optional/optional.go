@@ -0,0 +1,46 @@
+// package optional provides a type for values that may or may not be present
+package optional
+
+// Optional holds either a value (Some) or nothing (None).
+// The zero value is None.
+type Optional[T any] struct {
+	value   T
+	present bool
+}
+
+// Some wraps v in a present Optional
+func Some[T any](v T) Optional[T] {
+	return Optional[T]{value: v, present: true}
+}
+
+// None returns an empty Optional
+func None[T any]() Optional[T] {
+	return Optional[T]{}
+}
+
+// IsPresent reports whether the Optional holds a value
+func (o Optional[T]) IsPresent() bool {
+	return o.present
+}
+
+// Get returns the value and whether it was present, following the (value, ok) idiom
+func (o Optional[T]) Get() (T, bool) {
+	return o.value, o.present
+}
+
+// OrElse returns the value if present, otherwise def
+func (o Optional[T]) OrElse(def T) T {
+	if !o.present {
+		return def
+	}
+	return o.value
+}
+
+// Map applies f to the value of a present Optional and returns None otherwise.
+// It is a function rather than a method because Go methods can't introduce new type parameters.
+func Map[T, U any](o Optional[T], f func(T) U) Optional[U] {
+	if !o.present {
+		return None[U]()
+	}
+	return Some(f(o.value))
+}
@@ -0,0 +1,75 @@
+package optional
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestOptional(t *testing.T) {
+	t.Run("present value", func(t *testing.T) {
+		o := Some(42)
+
+		got, ok := o.Get()
+		if !ok || !o.IsPresent() {
+			t.Fatal("expected a value to be present")
+		}
+		if got != 42 {
+			t.Errorf("got %d want %d", got, 42)
+		}
+	})
+
+	t.Run("absent value", func(t *testing.T) {
+		o := None[int]()
+
+		got, ok := o.Get()
+		if ok || o.IsPresent() {
+			t.Fatal("expected no value to be present")
+		}
+		if got != 0 {
+			t.Errorf("got %d want zero value", got)
+		}
+	})
+
+	t.Run("zero value is none", func(t *testing.T) {
+		var o Optional[string]
+		if o.IsPresent() {
+			t.Error("expected the zero Optional to be empty")
+		}
+	})
+}
+
+func TestOrElse(t *testing.T) {
+	if got := Some(1).OrElse(7); got != 1 {
+		t.Errorf("got %d want %d", got, 1)
+	}
+	if got := None[int]().OrElse(7); got != 7 {
+		t.Errorf("got %d want %d", got, 7)
+	}
+}
+
+func TestMap(t *testing.T) {
+	t.Run("transforms a present value", func(t *testing.T) {
+		got, ok := Map(Some(12), strconv.Itoa).Get()
+		if !ok {
+			t.Fatal("expected a value to be present")
+		}
+		if got != "12" {
+			t.Errorf("got %q want %q", got, "12")
+		}
+	})
+
+	t.Run("leaves none unchanged", func(t *testing.T) {
+		called := false
+		o := Map(None[int](), func(i int) string {
+			called = true
+			return strconv.Itoa(i)
+		})
+
+		if o.IsPresent() {
+			t.Error("expected mapping None to stay None")
+		}
+		if called {
+			t.Error("mapping function should not be called for None")
+		}
+	})
+}
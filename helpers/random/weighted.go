@@ -0,0 +1,57 @@
+// package random provides helpers for making random choices
+package random
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+var (
+	ErrLengthMismatch = errors.New("items and weights must have the same length")
+	ErrNegativeWeight = errors.New("weights cannot be negative")
+	ErrNoWeight       = errors.New("at least one weight must be positive")
+	ErrWeightOverflow = errors.New("weights add up to more than an int can hold")
+)
+
+// WeightedChoice picks one of items with probability proportional to its weight,
+// so an item with weight 2 is picked twice as often as one with weight 1.
+// Items with weight 0 are never picked. A nil r uses the global source.
+// The weights must add up to at most math.MaxInt.
+func WeightedChoice[T any](items []T, weights []int, r *rand.Rand) (T, error) {
+	var zero T
+	if len(items) != len(weights) {
+		return zero, fmt.Errorf("%w: got %d items and %d weights", ErrLengthMismatch, len(items), len(weights))
+	}
+
+	total := 0
+	for i, w := range weights {
+		if w < 0 {
+			return zero, fmt.Errorf("%w: item %d has weight %d", ErrNegativeWeight, i, w)
+		}
+		if w > math.MaxInt-total {
+			return zero, fmt.Errorf("%w: at item %d", ErrWeightOverflow, i)
+		}
+		total += w
+	}
+	if total == 0 {
+		return zero, ErrNoWeight
+	}
+
+	intn := rand.Intn
+	if r != nil {
+		intn = r.Intn
+	}
+
+	// lay the weights end to end and see which one the random point lands in
+	point := intn(total)
+	for i, w := range weights {
+		if point < w {
+			return items[i], nil
+		}
+		point -= w
+	}
+	// unreachable, point is always less than total
+	return zero, ErrNoWeight
+}
@@ -0,0 +1,73 @@
+package random
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestWeightedChoice(t *testing.T) {
+	t.Run("seeded source gives a known pick", func(t *testing.T) {
+		got, err := WeightedChoice([]string{"a", "b", "c"}, []int{1, 1, 8}, rand.New(rand.NewSource(42)))
+		if err != nil {
+			t.Fatalf("did not expect an error but got one %v", err)
+		}
+		if got != "c" {
+			t.Errorf("got %q want %q", got, "c")
+		}
+	})
+
+	t.Run("zero weight items are never picked", func(t *testing.T) {
+		r := rand.New(rand.NewSource(1))
+		for i := 0; i < 1000; i++ {
+			got, _ := WeightedChoice([]string{"never", "always"}, []int{0, 3}, r)
+			if got != "always" {
+				t.Fatalf("got %q, an item with zero weight", got)
+			}
+		}
+	})
+
+	t.Run("distribution roughly matches the weights", func(t *testing.T) {
+		items := []string{"a", "b", "c"}
+		weights := []int{1, 2, 7}
+		r := rand.New(rand.NewSource(7))
+
+		const draws = 100000
+		counts := map[string]int{}
+		for i := 0; i < draws; i++ {
+			got, _ := WeightedChoice(items, weights, r)
+			counts[got]++
+		}
+
+		for i, item := range items {
+			want := float64(weights[i]) / 10
+			got := float64(counts[item]) / draws
+			if math.Abs(got-want) > 0.01 {
+				t.Errorf("%q picked %.3f of the time, want about %.3f", item, got, want)
+			}
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		cases := []struct {
+			name    string
+			weights []int
+			want    error
+		}{
+			{"length mismatch", []int{1}, ErrLengthMismatch},
+			{"all zero weights", []int{0, 0}, ErrNoWeight},
+			{"negative weight", []int{2, -1}, ErrNegativeWeight},
+			{"weights overflow", []int{math.MaxInt, math.MaxInt}, ErrWeightOverflow},
+		}
+
+		for _, tt := range cases {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := WeightedChoice([]int{1, 2}, tt.weights, nil)
+				if !errors.Is(err, tt.want) {
+					t.Errorf("got error %v want %v", err, tt.want)
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,58 @@
+// package registry provides a concurrency safe name to value registry
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+var ErrDuplicate = errors.New("name already registered")
+
+// Registry maps names to values, for things like looking up an implementation
+// by the name a user typed. It is safe for concurrent use.
+type Registry[V any] struct {
+	mu     sync.RWMutex
+	values map[string]V
+}
+
+// New creates an empty Registry
+func New[V any]() *Registry[V] {
+	return &Registry[V]{values: make(map[string]V)}
+}
+
+// Register adds v under name. Names can only be registered once, registering a
+// name again returns ErrDuplicate and leaves the original value in place.
+func (r *Registry[V]) Register(name string, v V) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.values[name]; ok {
+		return fmt.Errorf("%w: %q", ErrDuplicate, name)
+	}
+	r.values[name] = v
+	return nil
+}
+
+// Get returns the value registered under name, or false if there is none
+func (r *Registry[V]) Get(name string) (V, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	v, ok := r.values[name]
+	return v, ok
+}
+
+// Names returns every registered name in sorted order
+func (r *Registry[V]) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.values))
+	for name := range r.values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
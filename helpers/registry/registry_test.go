@@ -0,0 +1,99 @@
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestRegistry(t *testing.T) {
+	t.Run("register and get", func(t *testing.T) {
+		r := New[int]()
+		if err := r.Register("one", 1); err != nil {
+			t.Fatalf("did not expect an error but got one %v", err)
+		}
+
+		got, ok := r.Get("one")
+		if !ok || got != 1 {
+			t.Errorf("got %d, %t want 1, true", got, ok)
+		}
+	})
+
+	t.Run("missing name", func(t *testing.T) {
+		r := New[int]()
+
+		_, ok := r.Get("nope")
+		if ok {
+			t.Error("expected a missing name to return false")
+		}
+	})
+
+	t.Run("duplicate name keeps the original", func(t *testing.T) {
+		r := New[string]()
+		r.Register("greeting", "hello")
+
+		err := r.Register("greeting", "hi")
+		if !errors.Is(err, ErrDuplicate) {
+			t.Errorf("got error %v want %v", err, ErrDuplicate)
+		}
+		if got, _ := r.Get("greeting"); got != "hello" {
+			t.Errorf("got %q want %q", got, "hello")
+		}
+	})
+
+	t.Run("names are sorted", func(t *testing.T) {
+		r := New[int]()
+		r.Register("c", 3)
+		r.Register("a", 1)
+		r.Register("b", 2)
+
+		got := r.Names()
+		want := []string{"a", "b", "c"}
+		if !slices.Equal(got, want) {
+			t.Errorf("got %v want %v", got, want)
+		}
+	})
+}
+
+func TestRegistryConcurrent(t *testing.T) {
+	r := New[int]()
+	const workers = 50
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	failures := 0
+
+	for i := 0; i < workers; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			r.Register(fmt.Sprintf("worker-%d", i), i)
+			// every worker also races to claim the same name
+			if err := r.Register("shared", i); err != nil {
+				mu.Lock()
+				failures++
+				mu.Unlock()
+			}
+		}(i)
+		go func(i int) {
+			defer wg.Done()
+			r.Get(fmt.Sprintf("worker-%d", i))
+			r.Names()
+		}(i)
+	}
+	wg.Wait()
+
+	if failures != workers-1 {
+		t.Errorf("got %d failed registrations of the shared name want %d", failures, workers-1)
+	}
+	if got := len(r.Names()); got != workers+1 {
+		t.Errorf("got %d names want %d", got, workers+1)
+	}
+	for i := 0; i < workers; i++ {
+		if got, ok := r.Get(fmt.Sprintf("worker-%d", i)); !ok || got != i {
+			t.Errorf("got %d, %t for worker-%d", got, ok, i)
+		}
+	}
+}
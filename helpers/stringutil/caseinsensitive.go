@@ -0,0 +1,16 @@
+package stringutil
+
+import (
+	"strings"
+)
+
+// CaseInsensitiveCompare compares a and b ignoring case, so "apple" and "Apple"
+// end up next to each other when sorting. Strings that only differ in case are
+// ordered by a case-sensitive comparison, which puts upper case first and keeps
+// the result deterministic. It can be passed straight to SortWithComparator.
+func CaseInsensitiveCompare(a, b string) int {
+	if c := strings.Compare(strings.ToLower(a), strings.ToLower(b)); c != 0 {
+		return c
+	}
+	return strings.Compare(a, b)
+}
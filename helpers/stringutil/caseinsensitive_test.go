@@ -0,0 +1,39 @@
+package stringutil
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aziz-shoko/dsa-go/sorting/bubblesort"
+)
+
+func TestCaseInsensitiveCompare(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want int
+	}{
+		{"apple", "Banana", -1},
+		{"Banana", "apple", 1},
+		{"Apple", "apple", -1},
+		{"apple", "Apple", 1},
+		{"same", "same", 0},
+	}
+
+	for _, c := range cases {
+		got := CaseInsensitiveCompare(c.a, c.b)
+		if sign(got) != c.want {
+			t.Errorf("CaseInsensitiveCompare(%q, %q) = %d want sign %d", c.a, c.b, got, c.want)
+		}
+	}
+}
+
+func TestCaseInsensitiveCompareWithSort(t *testing.T) {
+	input := []string{"banana", "apple", "Cherry", "Apple", "BANANA", "cherry"}
+	want := []string{"Apple", "apple", "BANANA", "banana", "Cherry", "cherry"}
+
+	got := bubblesort.SortWithComparator(input, CaseInsensitiveCompare)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v want %v", got, want)
+	}
+}
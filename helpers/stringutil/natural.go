@@ -0,0 +1,71 @@
+// package stringutil provides string helpers that the standard library doesn't
+package stringutil
+
+import (
+	"strings"
+)
+
+// NaturalCompare compares a and b the way people expect file names to sort,
+// so "file2" comes before "file10". Both strings are split into runs of ASCII
+// digits and runs of everything else; digit runs compare by numeric value and
+// other runs compare lexically. Strings that are equal by that rule, like
+// "a01" and "a1", fall back to a plain comparison so the order stays total.
+// It returns a negative number, zero or a positive number like strings.Compare,
+// so it can be passed straight to SortWithComparator.
+func NaturalCompare(a, b string) int {
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		runA, digitsA := nextRun(a, i)
+		runB, digitsB := nextRun(b, j)
+		i += len(runA)
+		j += len(runB)
+
+		var c int
+		if digitsA && digitsB {
+			c = compareNumeric(runA, runB)
+		} else {
+			c = strings.Compare(runA, runB)
+		}
+		if c != 0 {
+			return c
+		}
+	}
+
+	// one string ran out first, the shorter one sorts first
+	switch {
+	case i < len(a):
+		return 1
+	case j < len(b):
+		return -1
+	}
+	return strings.Compare(a, b)
+}
+
+// nextRun returns the run of digits or non-digits starting at s[start]
+func nextRun(s string, start int) (run string, digits bool) {
+	digits = isDigit(s[start])
+	end := start + 1
+	for end < len(s) && isDigit(s[end]) == digits {
+		end++
+	}
+	return s[start:end], digits
+}
+
+// compareNumeric compares two digit runs by value without parsing them, so
+// runs too long for an int still work. With leading zeros trimmed the longer
+// number is the bigger one, and equal lengths compare digit by digit.
+func compareNumeric(a, b string) int {
+	a = strings.TrimLeft(a, "0")
+	b = strings.TrimLeft(b, "0")
+	if len(a) != len(b) {
+		if len(a) < len(b) {
+			return -1
+		}
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
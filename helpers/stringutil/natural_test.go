@@ -0,0 +1,68 @@
+package stringutil
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aziz-shoko/dsa-go/sorting/bubblesort"
+)
+
+func TestNaturalCompare(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want int
+	}{
+		{"file2", "file10", -1},
+		{"file10", "file2", 1},
+		{"file1", "file1", 0},
+		{"a", "b", -1},
+		{"file", "file1", -1},
+		{"x9y", "x10a", -1},
+		{"img12b", "img12a", 1},
+		{"a01", "a1", -1},
+		{"v99999999999999999999", "v100000000000000000000", -1},
+		{"", "", 0},
+	}
+
+	for _, c := range cases {
+		got := NaturalCompare(c.a, c.b)
+		if sign(got) != c.want {
+			t.Errorf("NaturalCompare(%q, %q) = %d want sign %d", c.a, c.b, got, c.want)
+		}
+	}
+}
+
+func TestNaturalCompareWithSort(t *testing.T) {
+	t.Run("file names", func(t *testing.T) {
+		input := []string{"file10", "file2", "file1"}
+		want := []string{"file1", "file2", "file10"}
+
+		got := bubblesort.SortWithComparator(input, NaturalCompare)
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got %v want %v", got, want)
+		}
+	})
+
+	t.Run("versions with several number runs", func(t *testing.T) {
+		input := []string{"v1.10.0", "v1.2.10", "v1.2.9", "v0.9"}
+		want := []string{"v0.9", "v1.2.9", "v1.2.10", "v1.10.0"}
+
+		got := bubblesort.SortWithComparator(input, NaturalCompare)
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got %v want %v", got, want)
+		}
+	})
+}
+
+func sign(n int) int {
+	switch {
+	case n < 0:
+		return -1
+	case n > 0:
+		return 1
+	default:
+		return 0
+	}
+}
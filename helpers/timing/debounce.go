@@ -0,0 +1,57 @@
+// package timing provides helpers for controlling when functions run
+package timing
+
+import (
+	"sync"
+	"time"
+)
+
+// Timer is the part of *time.Timer that Debounce relies on
+type Timer interface {
+	Stop() bool
+}
+
+// Clock schedules functions to run later. It exists so tests can swap in a fake
+// clock instead of waiting on real time.
+type Clock interface {
+	AfterFunc(d time.Duration, f func()) Timer
+}
+
+type realClock struct{}
+
+func (realClock) AfterFunc(d time.Duration, f func()) Timer {
+	return time.AfterFunc(d, f)
+}
+
+// Debounce returns a function that delays calling fn until d has passed since it
+// was last called, so a burst of calls results in a single call to fn.
+// The returned cancel function drops any pending call.
+func Debounce(d time.Duration, fn func()) (debounced func(), cancel func()) {
+	return DebounceWithClock(realClock{}, d, fn)
+}
+
+// DebounceWithClock is Debounce with the clock injected
+func DebounceWithClock(clock Clock, d time.Duration, fn func()) (debounced func(), cancel func()) {
+	var mu sync.Mutex
+	var timer Timer
+
+	debounced = func() {
+		mu.Lock()
+		defer mu.Unlock()
+		if timer != nil {
+			timer.Stop()
+		}
+		timer = clock.AfterFunc(d, fn)
+	}
+
+	cancel = func() {
+		mu.Lock()
+		defer mu.Unlock()
+		if timer != nil {
+			timer.Stop()
+			timer = nil
+		}
+	}
+
+	return debounced, cancel
+}
@@ -0,0 +1,92 @@
+package timing
+
+import (
+	"testing"
+	"time"
+)
+
+type fakeTimer struct {
+	at      time.Duration
+	fn      func()
+	stopped bool
+}
+
+func (t *fakeTimer) Stop() bool {
+	wasActive := !t.stopped
+	t.stopped = true
+	return wasActive
+}
+
+// FakeClock only moves forward when Advance is called
+type FakeClock struct {
+	now    time.Duration
+	timers []*fakeTimer
+}
+
+func (c *FakeClock) AfterFunc(d time.Duration, f func()) Timer {
+	timer := &fakeTimer{at: c.now + d, fn: f}
+	c.timers = append(c.timers, timer)
+	return timer
+}
+
+func (c *FakeClock) Advance(d time.Duration) {
+	c.now += d
+	for _, timer := range c.timers {
+		if !timer.stopped && timer.at <= c.now {
+			timer.stopped = true
+			timer.fn()
+		}
+	}
+}
+
+func TestDebounce(t *testing.T) {
+	t.Run("rapid calls are coalesced into one", func(t *testing.T) {
+		clock := &FakeClock{}
+		calls := 0
+		debounced, _ := DebounceWithClock(clock, 100*time.Millisecond, func() { calls++ })
+
+		for i := 0; i < 5; i++ {
+			debounced()
+			clock.Advance(50 * time.Millisecond)
+		}
+		assertCalls(t, calls, 0)
+
+		clock.Advance(50 * time.Millisecond)
+		assertCalls(t, calls, 1)
+
+		clock.Advance(time.Second)
+		assertCalls(t, calls, 1)
+	})
+
+	t.Run("cancel drops the pending call", func(t *testing.T) {
+		clock := &FakeClock{}
+		calls := 0
+		debounced, cancel := DebounceWithClock(clock, 100*time.Millisecond, func() { calls++ })
+
+		debounced()
+		cancel()
+		clock.Advance(time.Second)
+
+		assertCalls(t, calls, 0)
+	})
+
+	t.Run("uses real time by default", func(t *testing.T) {
+		done := make(chan struct{})
+		debounced, cancel := Debounce(5*time.Millisecond, func() { close(done) })
+		defer cancel()
+
+		debounced()
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("debounced function was never called")
+		}
+	})
+}
+
+func assertCalls(t testing.TB, got, want int) {
+	t.Helper()
+	if got != want {
+		t.Errorf("got %d calls, want %d", got, want)
+	}
+}
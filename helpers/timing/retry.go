@@ -0,0 +1,31 @@
+package timing
+
+import (
+	"context"
+	"time"
+)
+
+// Retry calls fn until it succeeds or attempts run out, returning the last error.
+// The wait between attempts starts at backoff and doubles after every failure.
+// If ctx is cancelled while waiting, Retry stops early and returns ctx.Err().
+// fn is always called at least once.
+func Retry(ctx context.Context, attempts int, backoff time.Duration, fn func() error) error {
+	var err error
+	for attempt := 1; ; attempt++ {
+		if err = fn(); err == nil {
+			return nil
+		}
+		if attempt >= attempts {
+			return err
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+		backoff *= 2
+	}
+}
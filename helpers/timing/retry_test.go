@@ -0,0 +1,55 @@
+package timing
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRetry(t *testing.T) {
+	t.Run("succeeds on the second attempt", func(t *testing.T) {
+		calls := 0
+		err := Retry(context.Background(), 3, time.Millisecond, func() error {
+			calls++
+			if calls < 2 {
+				return errors.New("not yet")
+			}
+			return nil
+		})
+
+		if err != nil {
+			t.Fatalf("did not expect an error but got one %v", err)
+		}
+		assertCalls(t, calls, 2)
+	})
+
+	t.Run("returns the last error when every attempt fails", func(t *testing.T) {
+		calls := 0
+		errs := []error{errors.New("first"), errors.New("second"), errors.New("third")}
+		err := Retry(context.Background(), 3, time.Millisecond, func() error {
+			calls++
+			return errs[calls-1]
+		})
+
+		if err != errs[2] {
+			t.Errorf("got error %v want %v", err, errs[2])
+		}
+		assertCalls(t, calls, 3)
+	})
+
+	t.Run("cancellation aborts early", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		calls := 0
+		err := Retry(ctx, 5, time.Hour, func() error {
+			calls++
+			cancel()
+			return errors.New("failed")
+		})
+
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("got error %v want %v", err, context.Canceled)
+		}
+		assertCalls(t, calls, 1)
+	})
+}
@@ -0,0 +1,75 @@
+// package binarysearch provides binary search over sorted slices
+package binarysearch
+
+import (
+	"errors"
+
+	"golang.org/x/exp/constraints"
+)
+
+var ErrNotSorted = errors.New("items are not sorted in ascending order")
+
+// Search looks for target in items, which must be sorted in ascending order.
+// It returns the index of target and true if it is present. Otherwise it
+// returns the index target would have to be inserted at to keep items sorted,
+// and false. With duplicate keys any matching index may be returned.
+// Time Complexity: O(log n)
+func Search[T constraints.Ordered](items []T, target T) (int, bool) {
+	return SearchFunc(items, func(item T) int {
+		switch {
+		case item < target:
+			return -1
+		case item > target:
+			return 1
+		default:
+			return 0
+		}
+	})
+}
+
+// SearchFunc is Search with a comparator for types that aren't ordered, following
+// the same negative/zero/positive convention as SortWithComparator with the target
+// baked in. cmp should return:
+// - negative value if item comes before the target
+// - zero if item matches the target
+// - positive value if item comes after the target
+// items must be sorted consistently with cmp.
+func SearchFunc[T any](items []T, cmp func(T) int) (int, bool) {
+	lo, hi := 0, len(items)
+	for lo < hi {
+		// written this way so lo+hi can't overflow on huge slices
+		mid := lo + (hi-lo)/2
+		c := cmp(items[mid])
+		switch {
+		case c < 0:
+			lo = mid + 1
+		case c > 0:
+			hi = mid
+		default:
+			return mid, true
+		}
+	}
+	return lo, false
+}
+
+// IsSorted reports whether items is sorted in ascending order
+// Time Complexity: O(n)
+func IsSorted[T constraints.Ordered](items []T) bool {
+	for i := 1; i < len(items); i++ {
+		if items[i] < items[i-1] {
+			return false
+		}
+	}
+	return true
+}
+
+// BinarySearchChecked is Search that checks its precondition first, returning
+// ErrNotSorted for unsorted input instead of a meaningless result.
+// The check makes it O(n), so it's meant for catching bugs rather than hot paths.
+func BinarySearchChecked[T constraints.Ordered](items []T, target T) (int, bool, error) {
+	if !IsSorted(items) {
+		return 0, false, ErrNotSorted
+	}
+	index, found := Search(items, target)
+	return index, found, nil
+}
@@ -0,0 +1,129 @@
+package binarysearch
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestSearch(t *testing.T) {
+	items := []int{1, 3, 5, 7, 9}
+
+	tests := []struct {
+		name      string
+		items     []int
+		target    int
+		wantIndex int
+		wantFound bool
+	}{
+		{"empty slice", []int{}, 5, 0, false},
+		{"smaller than all", items, 0, 0, false},
+		{"larger than all", items, 10, 5, false},
+		{"hit at the start", items, 1, 0, true},
+		{"hit at the end", items, 9, 4, true},
+		{"hit in the middle", items, 5, 2, true},
+		{"missing between elements", items, 4, 2, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			index, found := Search(tt.items, tt.target)
+
+			if index != tt.wantIndex || found != tt.wantFound {
+				t.Errorf("Search(%v, %d) = %d, %t want %d, %t", tt.items, tt.target, index, found, tt.wantIndex, tt.wantFound)
+			}
+		})
+	}
+
+	t.Run("duplicate keys", func(t *testing.T) {
+		items := []int{1, 2, 2, 2, 2, 3}
+
+		index, found := Search(items, 2)
+
+		if !found || items[index] != 2 {
+			t.Errorf("Search() = %d, %t want any index holding 2", index, found)
+		}
+	})
+}
+
+func TestSearchFunc(t *testing.T) {
+	type Person struct {
+		Name string
+		Age  int
+	}
+
+	// sorted by name
+	people := []Person{
+		{"Alice", 30},
+		{"Bob", 25},
+		{"Charlie", 35},
+		{"David", 20},
+	}
+
+	byName := func(name string) func(Person) int {
+		return func(p Person) int {
+			return strings.Compare(p.Name, name)
+		}
+	}
+
+	t.Run("found", func(t *testing.T) {
+		index, found := SearchFunc(people, byName("Charlie"))
+		if !found || index != 2 {
+			t.Errorf("SearchFunc() = %d, %t want 2, true", index, found)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		index, found := SearchFunc(people, byName("Bobby"))
+		if found || index != 2 {
+			t.Errorf("SearchFunc() = %d, %t want 2, false", index, found)
+		}
+	})
+}
+
+func TestIsSorted(t *testing.T) {
+	tests := []struct {
+		items []int
+		want  bool
+	}{
+		{[]int{}, true},
+		{[]int{1}, true},
+		{[]int{1, 2, 2, 3}, true},
+		{[]int{1, 3, 2}, false},
+	}
+
+	for _, tt := range tests {
+		if got := IsSorted(tt.items); got != tt.want {
+			t.Errorf("IsSorted(%v) = %t want %t", tt.items, got, tt.want)
+		}
+	}
+}
+
+func TestBinarySearchChecked(t *testing.T) {
+	t.Run("sorted hit", func(t *testing.T) {
+		index, found, err := BinarySearchChecked([]int{1, 3, 5}, 3)
+		if err != nil {
+			t.Fatalf("did not expect an error but got one %v", err)
+		}
+		if index != 1 || !found {
+			t.Errorf("got %d, %t want 1, true", index, found)
+		}
+	})
+
+	t.Run("sorted miss", func(t *testing.T) {
+		index, found, err := BinarySearchChecked([]int{1, 3, 5}, 4)
+		if err != nil {
+			t.Fatalf("did not expect an error but got one %v", err)
+		}
+		if index != 2 || found {
+			t.Errorf("got %d, %t want 2, false", index, found)
+		}
+	})
+
+	t.Run("unsorted input", func(t *testing.T) {
+		_, _, err := BinarySearchChecked([]int{5, 1, 3}, 3)
+		if !errors.Is(err, ErrNotSorted) {
+			t.Errorf("got error %v want %v", err, ErrNotSorted)
+		}
+	})
+}
@@ -0,0 +1,36 @@
+// package linearsearch provides linear search over slices that don't need to be sorted
+package linearsearch
+
+// Index returns the index of the first element equal to target, or -1 if there is none.
+// Time Complexity: O(n)
+func Index[T comparable](items []T, target T) int {
+	return IndexFunc(items, func(item T) bool {
+		return item == target
+	})
+}
+
+// IndexFunc returns the index of the first element satisfying pred, or -1 if
+// there is none. It works for element types that aren't comparable.
+// Time Complexity: O(n)
+func IndexFunc[T any](items []T, pred func(T) bool) int {
+	for i, item := range items {
+		if pred(item) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Indices returns the index of every element equal to target, in ascending order.
+// When nothing matches it returns nil rather than an empty slice, so callers can
+// check len(result) == 0 either way.
+// Time Complexity: O(n)
+func Indices[T comparable](items []T, target T) []int {
+	var indices []int
+	for i, item := range items {
+		if item == target {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
@@ -0,0 +1,71 @@
+package linearsearch
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIndex(t *testing.T) {
+	tests := []struct {
+		name   string
+		items  []int
+		target int
+		want   int
+	}{
+		{"empty slice", []int{}, 1, -1},
+		{"no match", []int{4, 2, 7}, 1, -1},
+		{"single match", []int{4, 2, 7}, 2, 1},
+		{"first of several matches", []int{4, 2, 7, 2}, 2, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Index(tt.items, tt.target); got != tt.want {
+				t.Errorf("Index(%v, %d) = %d want %d", tt.items, tt.target, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIndices(t *testing.T) {
+	tests := []struct {
+		name   string
+		items  []string
+		target string
+		want   []int
+	}{
+		{"no matches is nil", []string{"a", "b"}, "z", nil},
+		{"empty slice is nil", nil, "z", nil},
+		{"single match", []string{"a", "b", "c"}, "b", []int{1}},
+		{"multiple matches", []string{"b", "a", "b", "c", "b"}, "b", []int{0, 2, 4}},
+		{"adjacent duplicates", []string{"a", "b", "b", "b", "c"}, "b", []int{1, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Indices(tt.items, tt.target)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Indices(%v, %q) = %#v want %#v", tt.items, tt.target, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIndexFunc(t *testing.T) {
+	// slices aren't comparable, so Index can't be used here
+	items := [][]int{{1}, {2, 3}, {4, 5, 6}}
+
+	t.Run("found", func(t *testing.T) {
+		got := IndexFunc(items, func(s []int) bool { return len(s) == 2 })
+		if got != 1 {
+			t.Errorf("got %d want 1", got)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		got := IndexFunc(items, func(s []int) bool { return len(s) > 3 })
+		if got != -1 {
+			t.Errorf("got %d want -1", got)
+		}
+	})
+}
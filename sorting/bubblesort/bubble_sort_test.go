@@ -1,6 +1,8 @@
 package bubblesort
 
 import (
+	"fmt"
+	"math/rand"
 	"reflect"
 	"testing"
 )
@@ -86,6 +88,64 @@ func TestSort(t *testing.T) {
 	})
 }
 
+func TestSortDesc(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		expected []int
+	}{
+		{
+			name:     "empty slice",
+			input:    []int{},
+			expected: []int{},
+		},
+		{
+			name:     "single element",
+			input:    []int{1},
+			expected: []int{1},
+		},
+		{
+			name:     "ascending input",
+			input:    []int{1, 2, 3, 4, 5},
+			expected: []int{5, 4, 3, 2, 1},
+		},
+		{
+			name:     "with duplicates",
+			input:    []int{3, 1, 3, 1, 5, 5, 2},
+			expected: []int{5, 5, 3, 3, 2, 1, 1},
+		},
+		{
+			name:     "negative numbers",
+			input:    []int{-3, -1, -4, 1, -5, 9, -2},
+			expected: []int{9, 1, -1, -2, -3, -4, -5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := make([]int, len(tt.input))
+			copy(input, tt.input)
+
+			result := SortDesc(input)
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("SortDesc() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+
+	t.Run("string slice", func(t *testing.T) {
+		input := []string{"banana", "apple", "cherry", "Date"}
+		expected := []string{"cherry", "banana", "apple", "Date"}
+
+		result := SortDesc(input)
+
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("SortDesc()=%v, want %v", result, expected)
+		}
+	})
+}
+
 func TestSortWithComparator(t *testing.T) {
 	type Person struct {
 		Name string
@@ -140,24 +200,182 @@ func TestSortWithComparator(t *testing.T) {
 	})
 }
 
-func BenchmarkXxx(b *testing.B) {
-	sizes := []int{10, 100, 1000}
-	
-	for _, size := range sizes {
-		b.Run("size="+string(rune(size)), func(b *testing.B) {
-			// Create a worse-case scenario (reverse sorted)
-			input := make([]int, size)
-			for i:=0; i < size; i++ {
-				input[i] = size - i
+func TestSortStableWithComparator(t *testing.T) {
+	type record struct {
+		Key   int
+		Index int
+	}
+
+	byKey := func(a, b record) int {
+		return a.Key - b.Key
+	}
+
+	// assertStable checks the keys are sorted and equal keys kept their original index order
+	assertStable := func(t testing.TB, got []record) {
+		t.Helper()
+		for i := 1; i < len(got); i++ {
+			prev, cur := got[i-1], got[i]
+			if prev.Key > cur.Key {
+				t.Fatalf("not sorted at %d: %v", i, got)
+			}
+			if prev.Key == cur.Key && prev.Index > cur.Index {
+				t.Fatalf("equal keys out of input order at %d: %v", i, got)
 			}
+		}
+	}
+
+	t.Run("equal keys keep input order", func(t *testing.T) {
+		input := []record{{3, 0}, {1, 1}, {3, 2}, {2, 3}, {1, 4}}
+		expected := []record{{1, 1}, {1, 4}, {2, 3}, {3, 0}, {3, 2}}
 
-			b.ResetTimer()
-			for i:=0; i < b.N; i++ {
-				// Make a copy so we don't benefit from previous sorts
-				data := make([]int, len(input))
-				copy(data, input)
-				Sort(data)
+		result := SortStableWithComparator(input, byKey)
+
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("SortStableWithComparator()=%v, want %v", result, expected)
+		}
+	})
+
+	t.Run("many interleaved equal keys", func(t *testing.T) {
+		input := make([]record, 60)
+		for i := range input {
+			// keys cycle 2, 1, 0 so every key is spread across the whole slice
+			input[i] = record{Key: 2 - i%3, Index: i}
+		}
+
+		result := SortStableWithComparator(input, byKey)
+
+		assertStable(t, result)
+	})
+
+	t.Run("all keys equal", func(t *testing.T) {
+		input := []record{{7, 0}, {7, 1}, {7, 2}, {7, 3}}
+		expected := []record{{7, 0}, {7, 1}, {7, 2}, {7, 3}}
+
+		result := SortStableWithComparator(input, byKey)
+
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("SortStableWithComparator()=%v, want %v", result, expected)
+		}
+	})
+}
+
+func TestSortWithStats(t *testing.T) {
+	tests := []struct {
+		name            string
+		input           []int
+		expected        []int
+		wantComparisons int
+		wantSwaps       int
+	}{
+		{
+			name:            "already sorted",
+			input:           []int{1, 2, 3, 4, 5},
+			expected:        []int{1, 2, 3, 4, 5},
+			wantComparisons: 4,
+			wantSwaps:       0,
+		},
+		{
+			name:            "reverse sorted",
+			input:           []int{5, 4, 3, 2, 1},
+			expected:        []int{1, 2, 3, 4, 5},
+			wantComparisons: 10,
+			wantSwaps:       10,
+		},
+		{
+			name:            "nearly sorted",
+			input:           []int{1, 2, 3, 5, 4},
+			expected:        []int{1, 2, 3, 4, 5},
+			wantComparisons: 7,
+			wantSwaps:       1,
+		},
+		{
+			name:            "single element",
+			input:           []int{1},
+			expected:        []int{1},
+			wantComparisons: 0,
+			wantSwaps:       0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := make([]int, len(tt.input))
+			copy(input, tt.input)
+
+			result, stats := SortWithStats(input)
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("SortWithStats() = %v, want %v", result, tt.expected)
+			}
+			if stats.Comparisons != tt.wantComparisons {
+				t.Errorf("Comparisons = %d, want %d", stats.Comparisons, tt.wantComparisons)
+			}
+			if stats.Swaps != tt.wantSwaps {
+				t.Errorf("Swaps = %d, want %d", stats.Swaps, tt.wantSwaps)
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+func BenchmarkSort(b *testing.B) {
+	sizes := []int{10, 100, 1000}
+
+	scenarios := []struct {
+		name  string
+		build func(size int) []int
+	}{
+		{
+			// already sorted, a single pass with no swaps
+			name: "best",
+			build: func(size int) []int {
+				input := make([]int, size)
+				for i := 0; i < size; i++ {
+					input[i] = i
+				}
+				return input
+			},
+		},
+		{
+			// shuffled with a fixed seed so every run sorts the same input
+			name: "average",
+			build: func(size int) []int {
+				input := make([]int, size)
+				for i := 0; i < size; i++ {
+					input[i] = i
+				}
+				r := rand.New(rand.NewSource(1))
+				r.Shuffle(size, func(i, j int) {
+					input[i], input[j] = input[j], input[i]
+				})
+				return input
+			},
+		},
+		{
+			// reverse sorted, every comparison swaps
+			name: "worst",
+			build: func(size int) []int {
+				input := make([]int, size)
+				for i := 0; i < size; i++ {
+					input[i] = size - i
+				}
+				return input
+			},
+		},
+	}
+
+	for _, sc := range scenarios {
+		for _, size := range sizes {
+			b.Run(fmt.Sprintf("%s/size=%d", sc.name, size), func(b *testing.B) {
+				input := sc.build(size)
+
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					// Make a copy so we don't benefit from previous sorts
+					data := make([]int, len(input))
+					copy(data, input)
+					Sort(data)
+				}
+			})
+		}
+	}
+}
@@ -34,6 +34,31 @@ func Sort[T constraints.Ordered](items []T) []T {
 	return items
 }
 
+// SortDesc performs an in-place bubble sort in descending order and returns the slice.
+// It uses the same early exit as Sort, stopping after a pass with no swaps.
+// Time Complexity: O(n^2), O(n) when the slice is already in descending order
+func SortDesc[T constraints.Ordered](items []T) []T {
+	n := len(items)
+	if n <= 1 {
+		return items
+	}
+
+	swapped := true
+	for swapped {
+		swapped = false
+		for i := 0; i < n-1; i++ {
+			if items[i] < items[i+1] {
+				items[i], items[i+1] = items[i+1], items[i]
+				swapped = true
+			}
+		}
+		// the smallest element has sunk to the end
+		n--
+	}
+
+	return items
+}
+
 // SortWithComparator sorts the slice using a custom comparison function
 // The comparator function should return:
 // - negative value if a < b
@@ -61,3 +86,44 @@ func SortWithComparator[T any](items []T, comparator func(a, b T) int) []T {
 	return items
 }
 
+// SortStableWithComparator sorts the slice in-place using comparator and guarantees
+// the sort is stable: elements the comparator reports as equal keep their input order.
+// Bubble sort only swaps neighbours that are strictly out of order, so equal elements
+// never pass each other. SortWithComparator relies on the same rule, this name just
+// makes the guarantee part of the API for callers that depend on it.
+func SortStableWithComparator[T any](items []T, comparator func(a, b T) int) []T {
+	return SortWithComparator(items, comparator)
+}
+
+// Stats records how much work a sort did
+type Stats struct {
+	Comparisons int
+	Swaps       int
+}
+
+// SortWithStats sorts items in-place exactly like Sort, and also reports how many
+// comparisons and swaps it took. Because of the early exit an already sorted
+// slice only needs n-1 comparisons, while a reverse sorted one needs n(n-1)/2.
+func SortWithStats[T constraints.Ordered](items []T) ([]T, Stats) {
+	var stats Stats
+	n := len(items)
+	if n <= 1 {
+		return items, stats
+	}
+
+	swapped := true
+	for swapped {
+		swapped = false
+		for i := 0; i < n-1; i++ {
+			stats.Comparisons++
+			if items[i] > items[i+1] {
+				items[i], items[i+1] = items[i+1], items[i]
+				stats.Swaps++
+				swapped = true
+			}
+		}
+		n--
+	}
+
+	return items, stats
+}
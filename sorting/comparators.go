@@ -0,0 +1,22 @@
+package sorting
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// ByKey returns a comparator that orders values by the key extracted from them,
+// so it can be passed straight to SortWithComparator
+func ByKey[T any, K constraints.Ordered](key func(T) K) func(a, b T) int {
+	return func(a, b T) int {
+		return Compare(key(a), key(b)).Int()
+	}
+}
+
+// Reversed returns a comparator that orders values the opposite way to cmp.
+// It swaps the arguments instead of negating the result, which would overflow
+// for a comparator returning math.MinInt.
+func Reversed[T any](cmp func(a, b T) int) func(a, b T) int {
+	return func(a, b T) int {
+		return cmp(b, a)
+	}
+}
@@ -0,0 +1,87 @@
+package sorting
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aziz-shoko/dsa-go/sorting/bubblesort"
+)
+
+type Person struct {
+	Name string
+	Age  int
+}
+
+func TestByKey(t *testing.T) {
+	people := []Person{
+		{"Alice", 30},
+		{"Bob", 25},
+		{"Charlie", 35},
+		{"David", 20},
+	}
+	age := func(p Person) int { return p.Age }
+
+	t.Run("age ascending", func(t *testing.T) {
+		want := []Person{
+			{"David", 20},
+			{"Bob", 25},
+			{"Alice", 30},
+			{"Charlie", 35},
+		}
+
+		got := bubblesort.SortWithComparator(people, ByKey(age))
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got %v want %v", got, want)
+		}
+	})
+
+	t.Run("age descending", func(t *testing.T) {
+		want := []Person{
+			{"Charlie", 35},
+			{"Alice", 30},
+			{"Bob", 25},
+			{"David", 20},
+		}
+
+		got := bubblesort.SortWithComparator(people, Reversed(ByKey(age)))
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got %v want %v", got, want)
+		}
+	})
+
+	t.Run("string key", func(t *testing.T) {
+		want := []Person{
+			{"Alice", 30},
+			{"Bob", 25},
+			{"Charlie", 35},
+			{"David", 20},
+		}
+
+		got := bubblesort.SortWithComparator(people, ByKey(func(p Person) string { return p.Name }))
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got %v want %v", got, want)
+		}
+	})
+}
+
+func TestReversed(t *testing.T) {
+	cmp := Reversed(func(a, b int) int { return Compare(a, b).Int() })
+
+	tests := []struct {
+		name string
+		a, b int
+		want int
+	}{
+		{name: "less becomes greater", a: 1, b: 2, want: 1},
+		{name: "equal stays equal", a: 2, b: 2, want: 0},
+		{name: "greater becomes less", a: 3, b: 2, want: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cmp(tt.a, tt.b); got != tt.want {
+				t.Errorf("cmp(%d, %d) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+// package countingsort provides an implementation of the counting sort algorithm
+package countingsort
+
+import (
+	"fmt"
+)
+
+// maxRangeWidth caps max-min so a wide range panics with a clear message
+// instead of overflowing or trying to allocate gigabytes of counts
+const maxRangeWidth = 1 << 28
+
+// Sort sorts the provided slice in place by counting how often each value occurs,
+// finding the range with a scan first. It returns the slice for convenience.
+// Time Complexity: O(n + k) where k is max-min+1, the size of the value range
+// Space complexity: O(k) for the counts, so it only pays off for narrow ranges.
+// Like SortRange it panics if the values span more than maxRangeWidth.
+func Sort(items []int) []int {
+	if len(items) <= 1 {
+		return items
+	}
+
+	min, max := items[0], items[0]
+	for _, v := range items[1:] {
+		if v < min {
+			min = v
+		}
+		if v > max {
+			max = v
+		}
+	}
+
+	return SortRange(items, min, max)
+}
+
+// SortRange is Sort for callers that already know every value lies in [min, max],
+// which skips the scan for the bounds. Values are offset by min so negative
+// numbers work. It panics if min > max, the range is wider than maxRangeWidth,
+// or a value falls outside the range.
+func SortRange(items []int, min, max int) []int {
+	if min > max {
+		panic(fmt.Sprintf("countingsort: invalid range [%d, %d]", min, max))
+	}
+	// the subtraction wraps to the right answer in uint64 even when max-min overflows int
+	width := uint64(max) - uint64(min)
+	if width >= maxRangeWidth {
+		panic(fmt.Sprintf("countingsort: range too wide [%d, %d]", min, max))
+	}
+
+	counts := make([]int, width+1)
+	for _, v := range items {
+		if v < min || v > max {
+			panic(fmt.Sprintf("countingsort: value %d outside range [%d, %d]", v, min, max))
+		}
+		counts[v-min]++
+	}
+
+	i := 0
+	for offset, count := range counts {
+		for ; count > 0; count-- {
+			items[i] = offset + min
+			i++
+		}
+	}
+
+	return items
+}
@@ -0,0 +1,115 @@
+package countingsort
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSort(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		expected []int
+	}{
+		{
+			name:     "empty slice",
+			input:    []int{},
+			expected: []int{},
+		},
+		{
+			name:     "single element",
+			input:    []int{1},
+			expected: []int{1},
+		},
+		{
+			name:     "single repeated value",
+			input:    []int{4, 4, 4},
+			expected: []int{4, 4, 4},
+		},
+		{
+			name:     "reverse sorted",
+			input:    []int{5, 4, 3, 2, 1},
+			expected: []int{1, 2, 3, 4, 5},
+		},
+		{
+			name:     "with duplicates",
+			input:    []int{3, 1, 3, 1, 5, 5, 2},
+			expected: []int{1, 1, 2, 3, 3, 5, 5},
+		},
+		{
+			name:     "negative numbers",
+			input:    []int{-3, -1, -4, 1, -5, 9, -2},
+			expected: []int{-5, -4, -3, -2, -1, 1, 9},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := make([]int, len(tt.input))
+			copy(input, tt.input)
+
+			result := Sort(input)
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Sort() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSortRange(t *testing.T) {
+	t.Run("bounds wider than the values", func(t *testing.T) {
+		input := []int{2, -2, 0, 2}
+		expected := []int{-2, 0, 2, 2}
+
+		result := SortRange(input, -10, 10)
+
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("SortRange() = %v, want %v", result, expected)
+		}
+	})
+
+	t.Run("value outside the range panics", func(t *testing.T) {
+		assertPanics(t, func() { SortRange([]int{1, 5, 11}, 0, 10) })
+	})
+
+	t.Run("value below the range panics", func(t *testing.T) {
+		assertPanics(t, func() { SortRange([]int{-1, 5}, 0, 10) })
+	})
+
+	t.Run("inverted range panics", func(t *testing.T) {
+		assertPanics(t, func() { SortRange([]int{}, 5, 1) })
+	})
+
+	t.Run("range too wide panics", func(t *testing.T) {
+		assertPanicsWith(t, "range too wide", func() { SortRange([]int{0}, 0, maxRangeWidth) })
+	})
+
+	t.Run("range wider than an int panics", func(t *testing.T) {
+		assertPanicsWith(t, "range too wide", func() { Sort([]int{math.MinInt, math.MaxInt}) })
+	})
+}
+
+func assertPanics(t testing.TB, f func()) {
+	t.Helper()
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic")
+		}
+	}()
+	f()
+}
+
+// assertPanicsWith checks f panics with a message containing want
+func assertPanicsWith(t testing.TB, want string, f func()) {
+	t.Helper()
+	defer func() {
+		r := recover()
+		if msg, ok := r.(string); !ok || !strings.Contains(msg, want) {
+			t.Errorf("got panic %v want one containing %q", r, want)
+		}
+	}()
+	f()
+}
@@ -0,0 +1,53 @@
+// package heapsort provides an implementation of the heap sort algorithm
+package heapsort
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// Sort performs an in-place heap sort on the provided slice and returns it for convenience.
+// It builds a max heap, then repeatedly swaps the root (the largest element) to the
+// end of the slice and restores the heap on what is left.
+// Time Complexity: O(n log n) in every case
+// Space complexity: O(1) as the heap lives inside the slice
+func Sort[T constraints.Ordered](items []T) []T {
+	BuildMaxHeap(items)
+	for end := len(items) - 1; end > 0; end-- {
+		items[0], items[end] = items[end], items[0]
+		SiftDown(items[:end], 0)
+	}
+
+	return items
+}
+
+// BuildMaxHeap rearranges items in place so that every parent is >= its children,
+// where the children of index i are at 2i+1 and 2i+2.
+// Time Complexity: O(n), most elements sit near the bottom and barely move
+func BuildMaxHeap[T constraints.Ordered](items []T) {
+	// leaves are already heaps, so start from the last parent and work back to the root
+	for i := len(items)/2 - 1; i >= 0; i-- {
+		SiftDown(items, i)
+	}
+}
+
+// SiftDown moves items[i] down until it is >= both its children. The subtrees
+// below i must already be max heaps.
+// Time Complexity: O(log n)
+func SiftDown[T constraints.Ordered](items []T, i int) {
+	n := len(items)
+	for {
+		largest := i
+		left, right := 2*i+1, 2*i+2
+		if left < n && items[left] > items[largest] {
+			largest = left
+		}
+		if right < n && items[right] > items[largest] {
+			largest = right
+		}
+		if largest == i {
+			return
+		}
+		items[i], items[largest] = items[largest], items[i]
+		i = largest
+	}
+}
@@ -0,0 +1,123 @@
+package heapsort
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestSort(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		expected []int
+	}{
+		{
+			name:     "empty slice",
+			input:    []int{},
+			expected: []int{},
+		},
+		{
+			name:     "single element",
+			input:    []int{1},
+			expected: []int{1},
+		},
+		{
+			name:     "already sorted",
+			input:    []int{1, 2, 3, 4, 5},
+			expected: []int{1, 2, 3, 4, 5},
+		},
+		{
+			name:     "reverse sorted",
+			input:    []int{5, 4, 3, 2, 1},
+			expected: []int{1, 2, 3, 4, 5},
+		},
+		{
+			name:     "random order",
+			input:    []int{3, 1, 4, 1, 5, 9, 2, 6, 5},
+			expected: []int{1, 1, 2, 3, 4, 5, 5, 6, 9},
+		},
+		{
+			name:     "with duplicates",
+			input:    []int{3, 1, 3, 1, 5, 5, 2},
+			expected: []int{1, 1, 2, 3, 3, 5, 5},
+		},
+		{
+			name:     "negative numbers",
+			input:    []int{-3, -1, -4, 1, -5, 9, -2},
+			expected: []int{-5, -4, -3, -2, -1, 1, 9},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := make([]int, len(tt.input))
+			copy(input, tt.input)
+
+			result := Sort(input)
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Sort() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+
+	t.Run("string slice", func(t *testing.T) {
+		input := []string{"banana", "apple", "cherry", "date"}
+		expected := []string{"apple", "banana", "cherry", "date"}
+
+		result := Sort(input)
+
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Sort()=%v, want %v", result, expected)
+		}
+	})
+
+	t.Run("float slice", func(t *testing.T) {
+		input := []float64{3.14, 1.41, 2.71, 1.73}
+		expected := []float64{1.41, 1.73, 2.71, 3.14}
+
+		result := Sort(input)
+
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Sort()=%v, want %v", result, expected)
+		}
+	})
+}
+
+func TestBuildMaxHeap(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, size := range []int{0, 1, 2, 7, 8, 100} {
+		items := make([]int, size)
+		for i := range items {
+			items[i] = r.Intn(50)
+		}
+
+		BuildMaxHeap(items)
+
+		assertMaxHeap(t, items)
+	}
+}
+
+func TestSiftDown(t *testing.T) {
+	// both subtrees of the root are heaps, only the root is out of place
+	items := []int{1, 9, 8, 5, 4, 7, 6}
+
+	SiftDown(items, 0)
+
+	assertMaxHeap(t, items)
+	if items[0] != 9 {
+		t.Errorf("got root %d want 9", items[0])
+	}
+}
+
+// assertMaxHeap checks every parent is >= its children
+func assertMaxHeap(t testing.TB, items []int) {
+	t.Helper()
+	for i := 1; i < len(items); i++ {
+		parent := (i - 1) / 2
+		if items[parent] < items[i] {
+			t.Fatalf("heap invariant broken in %v: items[%d]=%d < items[%d]=%d", items, parent, items[parent], i, items[i])
+		}
+	}
+}
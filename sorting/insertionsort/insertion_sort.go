@@ -0,0 +1,47 @@
+// package insertionsort provides an implementation of the insertion sort algorithm
+package insertionsort
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// Sort performs an in-place insertion sort on the provided slice.
+// It returns the sorted slice for convenience.
+// Each element is shifted left past every larger element before it, so
+// nearly sorted input only needs a few shifts per element.
+// Time Complexity: O(n^2) in the worst case, O(n) when the slice is already sorted
+// Space complexity: O(1) as sorting is done in-place
+func Sort[T constraints.Ordered](items []T) []T {
+	for i := 1; i < len(items); i++ {
+		current := items[i]
+		j := i - 1
+		// shift larger elements one step right to open a gap for current
+		for j >= 0 && items[j] > current {
+			items[j+1] = items[j]
+			j--
+		}
+		items[j+1] = current
+	}
+
+	return items
+}
+
+// SortWithComparator sorts the slice using a custom comparison function
+// The comparator function should return:
+// - negative value if a < b
+// - zero if a == b
+// - positive value if a > b
+// Elements are only shifted past strictly greater ones, so the sort is stable.
+func SortWithComparator[T any](items []T, comparator func(a, b T) int) []T {
+	for i := 1; i < len(items); i++ {
+		current := items[i]
+		j := i - 1
+		for j >= 0 && comparator(items[j], current) > 0 {
+			items[j+1] = items[j]
+			j--
+		}
+		items[j+1] = current
+	}
+
+	return items
+}
@@ -0,0 +1,187 @@
+package insertionsort
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aziz-shoko/dsa-go/sorting/bubblesort"
+)
+
+func TestSort(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		expected []int
+	}{
+		{
+			name:     "empty slice",
+			input:    []int{},
+			expected: []int{},
+		},
+		{
+			name:     "single element",
+			input:    []int{1},
+			expected: []int{1},
+		},
+		{
+			name:     "already sorted",
+			input:    []int{1, 2, 3, 4, 5},
+			expected: []int{1, 2, 3, 4, 5},
+		},
+		{
+			name:     "reverse sorted",
+			input:    []int{5, 4, 3, 2, 1},
+			expected: []int{1, 2, 3, 4, 5},
+		},
+		{
+			name:     "random order",
+			input:    []int{3, 1, 4, 1, 5, 9, 2, 6, 5},
+			expected: []int{1, 1, 2, 3, 4, 5, 5, 6, 9},
+		},
+		{
+			name:     "with duplicates",
+			input:    []int{3, 1, 3, 1, 5, 5, 2},
+			expected: []int{1, 1, 2, 3, 3, 5, 5},
+		},
+		{
+			name:     "negative numbers",
+			input:    []int{-3, -1, -4, 1, -5, 9, -2},
+			expected: []int{-5, -4, -3, -2, -1, 1, 9},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Create a copy to avoid modifying the test data
+			input := make([]int, len(tt.input))
+			copy(input, tt.input)
+
+			result := Sort(input)
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Sort() = %v, want %v", result, tt.expected)
+			}
+
+			// both packages should agree on every input
+			bubbled := make([]int, len(tt.input))
+			copy(bubbled, tt.input)
+			if want := bubblesort.Sort(bubbled); !reflect.DeepEqual(result, want) {
+				t.Errorf("Sort() = %v, bubblesort.Sort() = %v", result, want)
+			}
+		})
+	}
+
+	t.Run("string slice", func(t *testing.T) {
+		input := []string{"banana", "apple", "cherry", "date"}
+		expected := []string{"apple", "banana", "cherry", "date"}
+
+		result := Sort(input)
+
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Sort()=%v, want %v", result, expected)
+		}
+	})
+
+	t.Run("float slice", func(t *testing.T) {
+		input := []float64{3.14, 1.41, 2.71, 1.73}
+		expected := []float64{1.41, 1.73, 2.71, 3.14}
+
+		result := Sort(input)
+
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Sort()=%v, want %v", result, expected)
+		}
+	})
+}
+
+func TestSortWithComparator(t *testing.T) {
+	type Person struct {
+		Name string
+		Age  int
+	}
+
+	people := []Person{
+		{"Alice", 30},
+		{"Bob", 25},
+		{"Charlie", 35},
+		{"David", 20},
+	}
+
+	t.Run("sort by age", func(t *testing.T) {
+		expected := []Person{
+			{"David", 20},
+			{"Bob", 25},
+			{"Alice", 30},
+			{"Charlie", 35},
+		}
+
+		result := SortWithComparator(people, func(a, b Person) int {
+			return a.Age - b.Age
+		})
+
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("SortWithComparator()=%v, want %v", result, expected)
+		}
+	})
+
+	t.Run("equal keys keep input order", func(t *testing.T) {
+		input := []Person{
+			{"Alice", 30},
+			{"Bob", 25},
+			{"Charlie", 30},
+			{"David", 25},
+		}
+		expected := []Person{
+			{"Bob", 25},
+			{"David", 25},
+			{"Alice", 30},
+			{"Charlie", 30},
+		}
+
+		result := SortWithComparator(input, func(a, b Person) int {
+			return a.Age - b.Age
+		})
+
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("SortWithComparator()=%v, want %v", result, expected)
+		}
+	})
+}
+
+// nearlySorted returns 1..size-1 in order followed by 0, every element but one is already in place
+func nearlySorted(size int) []int {
+	input := make([]int, size)
+	for i := 0; i < size-1; i++ {
+		input[i] = i + 1
+	}
+	return input
+}
+
+// BenchmarkNearlySorted compares insertion sort against bubble sort on nearly sorted input.
+// Insertion sort moves the misplaced 0 to the front in a single O(n) shift, while
+// bubble sort only moves it one step left per pass and needs n passes to get it there.
+func BenchmarkNearlySorted(b *testing.B) {
+	sorts := []struct {
+		name string
+		sort func([]int) []int
+	}{
+		{"insertionsort", Sort[int]},
+		{"bubblesort", bubblesort.Sort[int]},
+	}
+
+	for _, s := range sorts {
+		for _, size := range []int{100, 1000, 10000} {
+			b.Run(fmt.Sprintf("%s/size=%d", s.name, size), func(b *testing.B) {
+				input := nearlySorted(size)
+
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					data := make([]int, len(input))
+					copy(data, input)
+					s.sort(data)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// package mergesort provides an implementation of the merge sort algorithm
+package mergesort
+
+import (
+	"fmt"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Sort sorts the provided slice with a top-down merge sort and returns it for convenience.
+// Every merge allocates a temporary buffer for its result, use SortInto to avoid that.
+// The sort is stable.
+// Time Complexity: O(n log n) in every case
+// Space complexity: O(n) extra for the merge buffers
+func Sort[T constraints.Ordered](items []T) []T {
+	if len(items) <= 1 {
+		return items
+	}
+
+	mid := len(items) / 2
+	Sort(items[:mid])
+	Sort(items[mid:])
+
+	merged := make([]T, len(items))
+	merge(merged, items[:mid], items[mid:])
+	copy(items, merged)
+
+	return items
+}
+
+// SortInto sorts src in place, using dst as scratch space for the merges so no
+// memory is allocated. Reusing one dst across calls amortizes the buffer away.
+// dst must be at least as long as src, SortInto panics otherwise. The contents
+// of dst are overwritten.
+func SortInto[T constraints.Ordered](dst, src []T) {
+	if len(dst) < len(src) {
+		panic(fmt.Sprintf("mergesort: SortInto needs len(dst) >= len(src), got %d < %d", len(dst), len(src)))
+	}
+	sortInto(dst[:len(src)], src)
+}
+
+func sortInto[T constraints.Ordered](scratch, items []T) {
+	if len(items) <= 1 {
+		return
+	}
+
+	mid := len(items) / 2
+	sortInto(scratch[:mid], items[:mid])
+	sortInto(scratch[mid:], items[mid:])
+
+	merge(scratch, items[:mid], items[mid:])
+	copy(items, scratch)
+}
+
+// merge writes the sorted halves left and right into out, which must have room for both.
+// Ties take from left first, which is what keeps the sort stable.
+func merge[T constraints.Ordered](out, left, right []T) {
+	i, j, k := 0, 0, 0
+	for i < len(left) && j < len(right) {
+		if left[i] <= right[j] {
+			out[k] = left[i]
+			i++
+		} else {
+			out[k] = right[j]
+			j++
+		}
+		k++
+	}
+	k += copy(out[k:], left[i:])
+	copy(out[k:], right[j:])
+}
@@ -0,0 +1,136 @@
+package mergesort
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+var sortTests = []struct {
+	name     string
+	input    []int
+	expected []int
+}{
+	{
+		name:     "empty slice",
+		input:    []int{},
+		expected: []int{},
+	},
+	{
+		name:     "single element",
+		input:    []int{1},
+		expected: []int{1},
+	},
+	{
+		name:     "already sorted",
+		input:    []int{1, 2, 3, 4, 5},
+		expected: []int{1, 2, 3, 4, 5},
+	},
+	{
+		name:     "reverse sorted",
+		input:    []int{5, 4, 3, 2, 1},
+		expected: []int{1, 2, 3, 4, 5},
+	},
+	{
+		name:     "random order",
+		input:    []int{3, 1, 4, 1, 5, 9, 2, 6, 5},
+		expected: []int{1, 1, 2, 3, 4, 5, 5, 6, 9},
+	},
+	{
+		name:     "with duplicates",
+		input:    []int{3, 1, 3, 1, 5, 5, 2},
+		expected: []int{1, 1, 2, 3, 3, 5, 5},
+	},
+	{
+		name:     "negative numbers",
+		input:    []int{-3, -1, -4, 1, -5, 9, -2},
+		expected: []int{-5, -4, -3, -2, -1, 1, 9},
+	},
+}
+
+func TestSort(t *testing.T) {
+	for _, tt := range sortTests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := make([]int, len(tt.input))
+			copy(input, tt.input)
+
+			result := Sort(input)
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Sort() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+
+	t.Run("string slice", func(t *testing.T) {
+		input := []string{"banana", "apple", "cherry", "date"}
+		expected := []string{"apple", "banana", "cherry", "date"}
+
+		result := Sort(input)
+
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Sort()=%v, want %v", result, expected)
+		}
+	})
+}
+
+func TestSortInto(t *testing.T) {
+	for _, tt := range sortTests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := make([]int, len(tt.input))
+			copy(src, tt.input)
+			// a longer scratch buffer is fine, only the first len(src) elements are used
+			dst := make([]int, len(src)+3)
+
+			SortInto(dst, src)
+
+			if !reflect.DeepEqual(src, tt.expected) {
+				t.Errorf("SortInto() = %v, want %v", src, tt.expected)
+			}
+		})
+	}
+
+	t.Run("short dst panics", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("expected a panic")
+			}
+		}()
+		SortInto(make([]int, 2), []int{3, 2, 1})
+	})
+}
+
+func randomInts(size int) []int {
+	r := rand.New(rand.NewSource(1))
+	input := make([]int, size)
+	for i := range input {
+		input[i] = r.Int()
+	}
+	return input
+}
+
+func BenchmarkSort(b *testing.B) {
+	for _, size := range []int{100, 10000} {
+		input := randomInts(size)
+		data := make([]int, size)
+
+		b.Run(fmt.Sprintf("Sort/size=%d", size), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				copy(data, input)
+				Sort(data)
+			}
+		})
+
+		b.Run(fmt.Sprintf("SortInto/size=%d", size), func(b *testing.B) {
+			scratch := make([]int, size)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				copy(data, input)
+				SortInto(scratch, data)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// package sorting holds helpers shared by the sorting algorithm packages
+package sorting
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// Ordering is the result of comparing two values
+type Ordering int
+
+const (
+	Less    Ordering = -1
+	Equal   Ordering = 0
+	Greater Ordering = 1
+)
+
+// Compare returns how a orders relative to b
+func Compare[T constraints.Ordered](a, b T) Ordering {
+	switch {
+	case a < b:
+		return Less
+	case a > b:
+		return Greater
+	default:
+		return Equal
+	}
+}
+
+// FromInt converts a comparator result using the negative/zero/positive
+// convention of SortWithComparator into an Ordering
+func FromInt(n int) Ordering {
+	switch {
+	case n < 0:
+		return Less
+	case n > 0:
+		return Greater
+	default:
+		return Equal
+	}
+}
+
+// Int converts o back into the negative/zero/positive convention
+func (o Ordering) Int() int {
+	return int(o)
+}
+
+func (o Ordering) String() string {
+	switch o {
+	case Less:
+		return "Less"
+	case Equal:
+		return "Equal"
+	case Greater:
+		return "Greater"
+	default:
+		return "Ordering(invalid)"
+	}
+}
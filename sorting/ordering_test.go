@@ -0,0 +1,72 @@
+package sorting
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aziz-shoko/dsa-go/sorting/bubblesort"
+)
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b int
+		want Ordering
+	}{
+		{name: "less", a: 1, b: 2, want: Less},
+		{name: "equal", a: 2, b: 2, want: Equal},
+		{name: "greater", a: 3, b: 2, want: Greater},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Compare(tt.a, tt.b); got != tt.want {
+				t.Errorf("Compare(%d, %d) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+
+	t.Run("strings", func(t *testing.T) {
+		if got := Compare("apple", "banana"); got != Less {
+			t.Errorf("Compare() = %v, want %v", got, Less)
+		}
+	})
+}
+
+func TestIntConversion(t *testing.T) {
+	tests := []struct {
+		n    int
+		want Ordering
+	}{
+		{n: -42, want: Less},
+		{n: -1, want: Less},
+		{n: 0, want: Equal},
+		{n: 1, want: Greater},
+		{n: 7, want: Greater},
+	}
+
+	for _, tt := range tests {
+		got := FromInt(tt.n)
+		if got != tt.want {
+			t.Errorf("FromInt(%d) = %v, want %v", tt.n, got, tt.want)
+		}
+
+		// the sign has to survive the round trip for SortWithComparator to agree
+		if back := got.Int(); (back < 0) != (tt.n < 0) || (back > 0) != (tt.n > 0) {
+			t.Errorf("FromInt(%d).Int() = %d, sign does not match", tt.n, back)
+		}
+	}
+
+	t.Run("plugs into SortWithComparator", func(t *testing.T) {
+		input := []int{3, 1, 2}
+		expected := []int{1, 2, 3}
+
+		result := bubblesort.SortWithComparator(input, func(a, b int) int {
+			return Compare(a, b).Int()
+		})
+
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("SortWithComparator()=%v, want %v", result, expected)
+		}
+	})
+}
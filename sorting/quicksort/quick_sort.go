@@ -0,0 +1,111 @@
+// package quicksort provides an implementation of the quicksort algorithm
+package quicksort
+
+import (
+	"fmt"
+
+	"golang.org/x/exp/constraints"
+)
+
+// PivotStrategy chooses which element of a range becomes the partition pivot
+type PivotStrategy int
+
+const (
+	// PivotFirst uses the first element, already sorted input degrades to O(n^2)
+	PivotFirst PivotStrategy = iota
+	// PivotLast uses the last element, already sorted input degrades to O(n^2)
+	PivotLast
+	// PivotMiddle uses the element in the middle of the range
+	PivotMiddle
+	// PivotMedianOfThree uses the median of the first, middle and last elements
+	PivotMedianOfThree
+)
+
+func (s PivotStrategy) String() string {
+	switch s {
+	case PivotFirst:
+		return "First"
+	case PivotLast:
+		return "Last"
+	case PivotMiddle:
+		return "Middle"
+	case PivotMedianOfThree:
+		return "MedianOfThree"
+	default:
+		return "PivotStrategy(invalid)"
+	}
+}
+
+// Sort performs an in-place quicksort on the provided slice using the
+// median-of-three pivot, and returns the sorted slice for convenience.
+// Time Complexity: O(n log n) on average, O(n^2) in the worst case
+// Space complexity: O(log n) for the recursion, even in the worst case
+func Sort[T constraints.Ordered](items []T) []T {
+	return SortWithPivot(items, PivotMedianOfThree)
+}
+
+// SortWithPivot performs an in-place quicksort choosing pivots with strategy.
+// It panics if strategy is not one of the defined constants.
+// The sort is not stable.
+func SortWithPivot[T constraints.Ordered](items []T, strategy PivotStrategy) []T {
+	quicksort(items, 0, len(items)-1, strategy)
+	return items
+}
+
+// quicksort sorts items[lo..hi]. It only recurses into the smaller partition and
+// loops on the larger one, each recursive call at least halves the range so the
+// stack stays O(log n) deep even when a bad pivot makes the running time O(n^2).
+func quicksort[T constraints.Ordered](items []T, lo, hi int, strategy PivotStrategy) {
+	for lo < hi {
+		p := partition(items, lo, hi, pivotIndex(items, lo, hi, strategy))
+		if p-lo < hi-p {
+			quicksort(items, lo, p-1, strategy)
+			lo = p + 1
+		} else {
+			quicksort(items, p+1, hi, strategy)
+			hi = p - 1
+		}
+	}
+}
+
+// partition moves the pivot at index pivot to its final position within
+// items[lo..hi] with smaller elements before it and returns that position
+func partition[T constraints.Ordered](items []T, lo, hi, pivot int) int {
+	// park the pivot at the end so the Lomuto scan can ignore it
+	items[pivot], items[hi] = items[hi], items[pivot]
+	value := items[hi]
+
+	store := lo
+	for i := lo; i < hi; i++ {
+		if items[i] < value {
+			items[i], items[store] = items[store], items[i]
+			store++
+		}
+	}
+	items[store], items[hi] = items[hi], items[store]
+	return store
+}
+
+func pivotIndex[T constraints.Ordered](items []T, lo, hi int, strategy PivotStrategy) int {
+	mid := lo + (hi-lo)/2
+	switch strategy {
+	case PivotFirst:
+		return lo
+	case PivotLast:
+		return hi
+	case PivotMiddle:
+		return mid
+	case PivotMedianOfThree:
+		a, b, c := items[lo], items[mid], items[hi]
+		switch {
+		case (a <= b && b <= c) || (c <= b && b <= a):
+			return mid
+		case (b <= a && a <= c) || (c <= a && a <= b):
+			return lo
+		default:
+			return hi
+		}
+	default:
+		panic(fmt.Sprintf("quicksort: unknown pivot strategy %d", int(strategy)))
+	}
+}
@@ -0,0 +1,193 @@
+package quicksort
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"runtime/debug"
+	"testing"
+)
+
+var strategies = []PivotStrategy{PivotFirst, PivotLast, PivotMiddle, PivotMedianOfThree}
+
+func TestSortWithPivot(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		expected []int
+	}{
+		{
+			name:     "empty slice",
+			input:    []int{},
+			expected: []int{},
+		},
+		{
+			name:     "single element",
+			input:    []int{1},
+			expected: []int{1},
+		},
+		{
+			name:     "already sorted",
+			input:    []int{1, 2, 3, 4, 5},
+			expected: []int{1, 2, 3, 4, 5},
+		},
+		{
+			name:     "reverse sorted",
+			input:    []int{5, 4, 3, 2, 1},
+			expected: []int{1, 2, 3, 4, 5},
+		},
+		{
+			name:     "random order",
+			input:    []int{3, 1, 4, 1, 5, 9, 2, 6, 5},
+			expected: []int{1, 1, 2, 3, 4, 5, 5, 6, 9},
+		},
+		{
+			name:     "with duplicates",
+			input:    []int{3, 1, 3, 1, 5, 5, 2},
+			expected: []int{1, 1, 2, 3, 3, 5, 5},
+		},
+		{
+			name:     "all equal",
+			input:    []int{7, 7, 7, 7},
+			expected: []int{7, 7, 7, 7},
+		},
+		{
+			name:     "negative numbers",
+			input:    []int{-3, -1, -4, 1, -5, 9, -2},
+			expected: []int{-5, -4, -3, -2, -1, 1, 9},
+		},
+	}
+
+	for _, strategy := range strategies {
+		for _, tt := range tests {
+			t.Run(strategy.String()+"/"+tt.name, func(t *testing.T) {
+				input := make([]int, len(tt.input))
+				copy(input, tt.input)
+
+				result := SortWithPivot(input, strategy)
+
+				if !reflect.DeepEqual(result, tt.expected) {
+					t.Errorf("SortWithPivot() = %v, want %v", result, tt.expected)
+				}
+			})
+		}
+	}
+
+	t.Run("unknown strategy panics", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("expected a panic")
+			}
+		}()
+		SortWithPivot([]int{2, 1}, PivotStrategy(42))
+	})
+}
+
+func TestSort(t *testing.T) {
+	t.Run("string slice", func(t *testing.T) {
+		input := []string{"banana", "apple", "cherry", "date"}
+		expected := []string{"apple", "banana", "cherry", "date"}
+
+		result := Sort(input)
+
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Sort()=%v, want %v", result, expected)
+		}
+	})
+
+	t.Run("large random slice", func(t *testing.T) {
+		r := rand.New(rand.NewSource(1))
+		input := make([]int, 1000)
+		for i := range input {
+			input[i] = r.Intn(100)
+		}
+
+		result := Sort(input)
+
+		for i := 1; i < len(result); i++ {
+			if result[i-1] > result[i] {
+				t.Fatalf("not sorted at index %d: %v > %v", i, result[i-1], result[i])
+			}
+		}
+	})
+}
+
+func TestSortWorstCaseStackDepth(t *testing.T) {
+	if testing.Short() {
+		t.Skip("worst case inputs are quadratic")
+	}
+
+	// the default max stack is 1GB, shrink it so recursing once per element would
+	// blow up while the O(log n) depth of smaller-side recursion fits easily
+	old := debug.SetMaxStack(256 << 10)
+	defer debug.SetMaxStack(old)
+
+	const size = 10000
+
+	sorted := make([]int, size)
+	for i := range sorted {
+		sorted[i] = i
+	}
+	// every element equal puts the pivot at the edge of each partition, whatever the strategy
+	equal := make([]int, size)
+
+	cases := []struct {
+		name     string
+		input    []int
+		strategy PivotStrategy
+	}{
+		{"sorted input with first pivot", sorted, PivotFirst},
+		{"sorted input with last pivot", sorted, PivotLast},
+		{"equal elements with median of three", equal, PivotMedianOfThree},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			input := make([]int, len(c.input))
+			copy(input, c.input)
+
+			result := SortWithPivot(input, c.strategy)
+
+			if !reflect.DeepEqual(result, c.input) {
+				t.Error("SortWithPivot() did not return the sorted input")
+			}
+		})
+	}
+}
+
+// BenchmarkSortWithPivot shows how the pivot choice behaves on sorted input, where
+// PivotFirst and PivotLast always split off a single element and go quadratic.
+func BenchmarkSortWithPivot(b *testing.B) {
+	const size = 5000
+
+	sorted := make([]int, size)
+	for i := range sorted {
+		sorted[i] = i
+	}
+	random := make([]int, size)
+	copy(random, sorted)
+	r := rand.New(rand.NewSource(1))
+	r.Shuffle(size, func(i, j int) {
+		random[i], random[j] = random[j], random[i]
+	})
+
+	inputs := []struct {
+		name string
+		data []int
+	}{
+		{"sorted", sorted},
+		{"random", random},
+	}
+
+	for _, in := range inputs {
+		for _, strategy := range strategies {
+			b.Run(fmt.Sprintf("%s/%s", in.name, strategy), func(b *testing.B) {
+				data := make([]int, size)
+				for i := 0; i < b.N; i++ {
+					copy(data, in.data)
+					SortWithPivot(data, strategy)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,93 @@
+// package bloom provides a bloom filter for probabilistic set membership
+package bloom
+
+import (
+	"hash/fnv"
+	"math"
+)
+
+// DefaultFPRate is the false positive rate New uses when given one outside (0, 1)
+const DefaultFPRate = 0.01
+
+// BloomFilter answers "have I seen this item?" using a fixed amount of memory.
+// It can report false positives but never false negatives: Contains always
+// returns true for an item that was added.
+type BloomFilter struct {
+	bits   []uint64
+	m      uint64 // number of bits
+	hashes uint64 // number of hash functions
+}
+
+// New creates a BloomFilter sized so that after expectedItems additions the
+// false positive rate is about fpRate.
+//
+// The optimal sizes are
+//
+//	m = -n * ln(p) / ln(2)^2   bits
+//	k = m / n * ln(2)          hash functions
+//
+// for n expected items and false positive rate p.
+//
+// Out of range arguments fall back to usable values rather than failing:
+// an expectedItems below one sizes the filter for a single item, and an fpRate
+// outside (0, 1), including NaN, uses DefaultFPRate.
+func New(expectedItems int, fpRate float64) *BloomFilter {
+	if expectedItems < 1 {
+		expectedItems = 1
+	}
+	if !(fpRate > 0 && fpRate < 1) {
+		fpRate = DefaultFPRate
+	}
+
+	n := float64(expectedItems)
+	m := math.Ceil(-n * math.Log(fpRate) / (math.Ln2 * math.Ln2))
+	k := math.Max(1, math.Round(m/n*math.Ln2))
+
+	return &BloomFilter{
+		bits:   make([]uint64, (uint64(m)+63)/64),
+		m:      uint64(m),
+		hashes: uint64(k),
+	}
+}
+
+// Add records item in the filter
+func (b *BloomFilter) Add(item []byte) {
+	h1, h2 := hashPair(item)
+	for i := uint64(0); i < b.hashes; i++ {
+		pos := (h1 + i*h2) % b.m
+		b.bits[pos/64] |= 1 << (pos % 64)
+	}
+}
+
+// Contains reports whether item may have been added. False means it definitely was not.
+func (b *BloomFilter) Contains(item []byte) bool {
+	h1, h2 := hashPair(item)
+	for i := uint64(0); i < b.hashes; i++ {
+		pos := (h1 + i*h2) % b.m
+		if b.bits[pos/64]&(1<<(pos%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Size returns the number of bits in the filter
+func (b *BloomFilter) Size() int {
+	return int(b.m)
+}
+
+// Hashes returns the number of hash functions used per item
+func (b *BloomFilter) Hashes() int {
+	return int(b.hashes)
+}
+
+// hashPair derives two independent hashes of item. The k hash functions are then
+// simulated with double hashing, h1 + i*h2, instead of computing k real hashes.
+func hashPair(item []byte) (uint64, uint64) {
+	a := fnv.New64a()
+	a.Write(item)
+	b := fnv.New64()
+	b.Write(item)
+	// an odd step never collapses onto a single position
+	return a.Sum64(), b.Sum64() | 1
+}
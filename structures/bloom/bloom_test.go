@@ -0,0 +1,88 @@
+package bloom
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestBloomFilter(t *testing.T) {
+	const items = 1000
+	const fpRate = 0.01
+
+	t.Run("computes optimal sizes", func(t *testing.T) {
+		b := New(items, fpRate)
+
+		// about 9.59 bits per item and 7 hash functions for a 1% rate
+		if b.Size() != 9586 {
+			t.Errorf("got %d bits want %d", b.Size(), 9586)
+		}
+		if b.Hashes() != 7 {
+			t.Errorf("got %d hashes want %d", b.Hashes(), 7)
+		}
+	})
+
+	t.Run("out of range arguments fall back to defaults", func(t *testing.T) {
+		want := New(1, DefaultFPRate)
+
+		cases := []struct {
+			name          string
+			expectedItems int
+			fpRate        float64
+		}{
+			{"zero items", 0, DefaultFPRate},
+			{"negative items", -5, DefaultFPRate},
+			{"rate above one", 1, 1.5},
+			{"rate of zero", 1, 0},
+			{"negative rate", 1, -0.1},
+			{"NaN rate", 1, math.NaN()},
+			{"both out of range", 0, 1.5},
+		}
+
+		for _, tt := range cases {
+			t.Run(tt.name, func(t *testing.T) {
+				got := New(tt.expectedItems, tt.fpRate)
+				if got.Size() != want.Size() || got.Hashes() != want.Hashes() {
+					t.Errorf("got %d bits and %d hashes want %d and %d", got.Size(), got.Hashes(), want.Size(), want.Hashes())
+				}
+			})
+		}
+	})
+
+	t.Run("never reports a false negative", func(t *testing.T) {
+		b := New(items, fpRate)
+		for i := 0; i < items; i++ {
+			b.Add(member(i))
+		}
+
+		for i := 0; i < items; i++ {
+			if !b.Contains(member(i)) {
+				t.Fatalf("expected %q to be found", member(i))
+			}
+		}
+	})
+
+	t.Run("false positive rate stays near the configured rate", func(t *testing.T) {
+		b := New(items, fpRate)
+		for i := 0; i < items; i++ {
+			b.Add(member(i))
+		}
+
+		const trials = 100000
+		falsePositives := 0
+		for i := 0; i < trials; i++ {
+			if b.Contains([]byte(fmt.Sprintf("non-member-%d", i))) {
+				falsePositives++
+			}
+		}
+
+		observed := float64(falsePositives) / trials
+		if observed > fpRate*2 {
+			t.Errorf("observed false positive rate %.4f, want close to %.4f", observed, fpRate)
+		}
+	})
+}
+
+func member(i int) []byte {
+	return []byte(fmt.Sprintf("member-%d", i))
+}
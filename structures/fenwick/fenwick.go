@@ -0,0 +1,63 @@
+// package fenwick provides a Fenwick (binary indexed) tree for prefix sums
+package fenwick
+
+import (
+	"fmt"
+)
+
+// BIT keeps running prefix sums over n values that start at zero.
+// Add and PrefixSum are O(log n).
+//
+// The API is 0-indexed, internally the tree is 1-indexed so that the lowest set
+// bit of an index (i & -i) gives the size of the range that slot covers.
+// Indices outside [0, n) panic, the same as indexing a slice would.
+type BIT struct {
+	tree []int
+}
+
+// New creates a BIT over n zero values
+func New(n int) *BIT {
+	return &BIT{tree: make([]int, n+1)}
+}
+
+// Len returns the number of values in the tree
+func (b *BIT) Len() int {
+	return len(b.tree) - 1
+}
+
+// Add adds delta to the value at index i
+func (b *BIT) Add(i, delta int) {
+	b.checkIndex(i)
+	for i++; i < len(b.tree); i += i & -i {
+		b.tree[i] += delta
+	}
+}
+
+// PrefixSum returns the sum of the values from index 0 up to and including i.
+// PrefixSum(-1) is the empty sum, 0.
+func (b *BIT) PrefixSum(i int) int {
+	if i == -1 {
+		return 0
+	}
+	b.checkIndex(i)
+
+	sum := 0
+	for i++; i > 0; i -= i & -i {
+		sum += b.tree[i]
+	}
+	return sum
+}
+
+// RangeSum returns the sum of the values between l and r inclusive
+func (b *BIT) RangeSum(l, r int) int {
+	if l > r {
+		panic(fmt.Sprintf("fenwick: invalid range [%d, %d]", l, r))
+	}
+	return b.PrefixSum(r) - b.PrefixSum(l-1)
+}
+
+func (b *BIT) checkIndex(i int) {
+	if i < 0 || i >= b.Len() {
+		panic(fmt.Sprintf("fenwick: index %d out of range [0, %d)", i, b.Len()))
+	}
+}
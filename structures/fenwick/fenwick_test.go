@@ -0,0 +1,62 @@
+package fenwick
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestBIT(t *testing.T) {
+	t.Run("matches a brute force reference over random updates", func(t *testing.T) {
+		const size = 50
+		r := rand.New(rand.NewSource(99))
+		bit := New(size)
+		reference := make([]int, size)
+
+		for op := 0; op < 2000; op++ {
+			i := r.Intn(size)
+			delta := r.Intn(201) - 100
+			bit.Add(i, delta)
+			reference[i] += delta
+
+			q := r.Intn(size)
+			if got, want := bit.PrefixSum(q), bruteForcePrefix(reference, q); got != want {
+				t.Fatalf("PrefixSum(%d) got %d want %d", q, got, want)
+			}
+
+			l, rr := r.Intn(size), r.Intn(size)
+			if l > rr {
+				l, rr = rr, l
+			}
+			want := bruteForcePrefix(reference, rr) - bruteForcePrefix(reference, l-1)
+			if got := bit.RangeSum(l, rr); got != want {
+				t.Fatalf("RangeSum(%d, %d) got %d want %d", l, rr, got, want)
+			}
+		}
+	})
+
+	t.Run("prefix sum before the first index is zero", func(t *testing.T) {
+		bit := New(3)
+		bit.Add(0, 5)
+
+		if got := bit.PrefixSum(-1); got != 0 {
+			t.Errorf("got %d want %d", got, 0)
+		}
+	})
+
+	t.Run("out of range index panics", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("expected Add past the end to panic")
+			}
+		}()
+		New(3).Add(3, 1)
+	})
+}
+
+func bruteForcePrefix(values []int, i int) int {
+	sum := 0
+	for j := 0; j <= i; j++ {
+		sum += values[j]
+	}
+	return sum
+}
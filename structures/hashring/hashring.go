@@ -0,0 +1,125 @@
+// package hashring provides a consistent hashing ring for spreading keys across nodes
+package hashring
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"slices"
+	"strconv"
+)
+
+// DefaultReplicas is the number of virtual nodes placed on the ring for each real node
+const DefaultReplicas = 100
+
+// Ring maps keys to nodes with consistent hashing. Every node is placed on the
+// ring many times (virtual nodes) so keys spread evenly, and a key belongs to the
+// first virtual node found walking clockwise from the key's hash. Adding or
+// removing a node therefore only moves the keys next to that node's points.
+//
+// Two virtual nodes can hash to the same point. Every node claiming a point is
+// kept, and the smallest id owns it, so the ring looks the same whatever order
+// nodes were added and removed in.
+type Ring struct {
+	replicas  int
+	points    []uint32            // sorted hashes of every virtual node
+	claimants map[uint32][]string // virtual node hash -> sorted ids placed there, the first owns it
+	nodes     map[string]bool
+	hash      func(string) uint32 // the package hash, tests swap it to force collisions
+}
+
+// New creates an empty Ring placing replicas virtual nodes per node.
+// A replicas value below one uses DefaultReplicas.
+func New(replicas int) *Ring {
+	if replicas < 1 {
+		replicas = DefaultReplicas
+	}
+	return &Ring{
+		replicas:  replicas,
+		claimants: make(map[uint32][]string),
+		nodes:     make(map[string]bool),
+		hash:      hash,
+	}
+}
+
+// AddNode places id on the ring. Adding a node that is already present does nothing.
+func (r *Ring) AddNode(id string) {
+	if r.nodes[id] {
+		return
+	}
+	r.nodes[id] = true
+
+	for i := 0; i < r.replicas; i++ {
+		h := r.virtualNode(id, i)
+		claimants, taken := r.claimants[h]
+		if !taken {
+			r.points = append(r.points, h)
+		}
+		at, _ := slices.BinarySearch(claimants, id)
+		r.claimants[h] = slices.Insert(claimants, at, id)
+	}
+	slices.Sort(r.points)
+}
+
+// RemoveNode takes id off the ring, its keys move to the neighbouring nodes
+func (r *Ring) RemoveNode(id string) {
+	if !r.nodes[id] {
+		return
+	}
+	delete(r.nodes, id)
+
+	for i := 0; i < r.replicas; i++ {
+		h := r.virtualNode(id, i)
+		claimants := r.claimants[h]
+		if at := slices.Index(claimants, id); at >= 0 {
+			claimants = slices.Delete(claimants, at, at+1)
+		}
+		if len(claimants) > 0 {
+			// another node placed a point here too, it takes the point over
+			r.claimants[h] = claimants
+			continue
+		}
+		delete(r.claimants, h)
+	}
+
+	r.points = slices.DeleteFunc(r.points, func(p uint32) bool {
+		_, claimed := r.claimants[p]
+		return !claimed
+	})
+}
+
+// Get returns the node that owns key, or an empty string if the ring has no nodes
+func (r *Ring) Get(key string) string {
+	if len(r.points) == 0 {
+		return ""
+	}
+
+	h := r.hash(key)
+	i, _ := slices.BinarySearch(r.points, h)
+	if i == len(r.points) {
+		// wrap around to the start of the ring
+		i = 0
+	}
+	return r.claimants[r.points[i]][0]
+}
+
+// Nodes returns the ids of the nodes on the ring in sorted order
+func (r *Ring) Nodes() []string {
+	ids := make([]string, 0, len(r.nodes))
+	for id := range r.nodes {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// virtualNode returns where the i-th virtual node of id sits on the ring
+func (r *Ring) virtualNode(id string, i int) uint32 {
+	return r.hash(id + "#" + strconv.Itoa(i))
+}
+
+// hash uses sha256 rather than a cheaper checksum because similar ids like
+// "node#1" and "node#2" need to land far apart for the ring to stay balanced
+func hash(s string) uint32 {
+	sum := sha256.Sum256([]byte(s))
+	return binary.BigEndian.Uint32(sum[:4])
+}
@@ -0,0 +1,160 @@
+package hashring
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func keys(n int) []string {
+	k := make([]string, n)
+	for i := range k {
+		k[i] = fmt.Sprintf("key-%d", i)
+	}
+	return k
+}
+
+func TestRing(t *testing.T) {
+	t.Run("empty ring owns nothing", func(t *testing.T) {
+		if got := New(0).Get("anything"); got != "" {
+			t.Errorf("got %q want empty string", got)
+		}
+	})
+
+	t.Run("adding a node only moves keys to that node", func(t *testing.T) {
+		r := New(DefaultReplicas)
+		r.AddNode("a")
+		r.AddNode("b")
+		r.AddNode("c")
+
+		before := map[string]string{}
+		for _, k := range keys(10000) {
+			before[k] = r.Get(k)
+		}
+
+		r.AddNode("d")
+
+		moved := 0
+		for k, owner := range before {
+			now := r.Get(k)
+			if now == owner {
+				continue
+			}
+			moved++
+			if now != "d" {
+				t.Fatalf("key %q moved from %q to %q, only moves to the new node are allowed", k, owner, now)
+			}
+		}
+		if moved == 0 {
+			t.Error("expected the new node to take over some keys")
+		}
+	})
+
+	t.Run("removing a node only remaps its own keys", func(t *testing.T) {
+		r := New(DefaultReplicas)
+		for _, id := range []string{"a", "b", "c", "d"} {
+			r.AddNode(id)
+		}
+
+		before := map[string]string{}
+		for _, k := range keys(10000) {
+			before[k] = r.Get(k)
+		}
+
+		r.RemoveNode("b")
+
+		for k, owner := range before {
+			now := r.Get(k)
+			if owner != "b" && now != owner {
+				t.Fatalf("key %q moved from %q to %q although its node was not removed", k, owner, now)
+			}
+			if now == "b" {
+				t.Fatalf("key %q still maps to the removed node", k)
+			}
+		}
+	})
+
+	t.Run("keys are balanced across nodes", func(t *testing.T) {
+		r := New(DefaultReplicas)
+		nodes := []string{"a", "b", "c", "d"}
+		for _, id := range nodes {
+			r.AddNode(id)
+		}
+
+		const total = 100000
+		counts := map[string]int{}
+		for _, k := range keys(total) {
+			counts[r.Get(k)]++
+		}
+
+		fair := total / len(nodes)
+		for _, id := range nodes {
+			if counts[id] < fair*7/10 || counts[id] > fair*13/10 {
+				t.Errorf("node %q owns %d keys, want within 30%% of %d", id, counts[id], fair)
+			}
+		}
+	})
+
+	t.Run("placement does not depend on history", func(t *testing.T) {
+		fresh := New(DefaultReplicas)
+		fresh.AddNode("a")
+		fresh.AddNode("c")
+
+		churned := New(DefaultReplicas)
+		for _, id := range []string{"c", "b", "a"} {
+			churned.AddNode(id)
+		}
+		churned.RemoveNode("b")
+		churned.RemoveNode("a")
+		churned.AddNode("a")
+
+		if !slices.Equal(fresh.points, churned.points) {
+			t.Fatal("rings holding the same nodes have different points")
+		}
+		for _, k := range keys(1000) {
+			if got, want := churned.Get(k), fresh.Get(k); got != want {
+				t.Fatalf("key %q maps to %q want %q", k, got, want)
+			}
+		}
+	})
+}
+
+func TestRingCollisions(t *testing.T) {
+	// with a single point every virtual node of every node collides
+	newColliding := func() *Ring {
+		r := New(2)
+		r.hash = func(string) uint32 { return 42 }
+		return r
+	}
+
+	t.Run("smallest id owns a shared point whatever the insertion order", func(t *testing.T) {
+		for _, order := range [][]string{{"a", "b"}, {"b", "a"}} {
+			r := newColliding()
+			for _, id := range order {
+				r.AddNode(id)
+			}
+			if got := r.Get("key"); got != "a" {
+				t.Errorf("added %v, got owner %q want %q", order, got, "a")
+			}
+		}
+	})
+
+	t.Run("removing the owner hands the point back", func(t *testing.T) {
+		r := newColliding()
+		r.AddNode("a")
+		r.AddNode("b")
+
+		r.RemoveNode("a")
+		if got := r.Get("key"); got != "b" {
+			t.Errorf("got owner %q want %q", got, "b")
+		}
+
+		r.RemoveNode("b")
+		if got := r.Get("key"); got != "" {
+			t.Errorf("got owner %q want empty string", got)
+		}
+		if len(r.points) != 0 || len(r.claimants) != 0 {
+			t.Errorf("got points %v claimants %v want an empty ring", r.points, r.claimants)
+		}
+	})
+}
@@ -0,0 +1,120 @@
+// package matrix provides a generic numeric matrix
+package matrix
+
+import (
+	"errors"
+	"fmt"
+
+	"golang.org/x/exp/constraints"
+)
+
+var (
+	ErrDimensionMismatch = errors.New("matrix dimensions do not match")
+	ErrRaggedRows        = errors.New("matrix rows have different lengths")
+)
+
+// Number is any integer or floating point type
+type Number interface {
+	constraints.Integer | constraints.Float
+}
+
+// Matrix is a rows x cols grid of numbers stored in row-major order.
+// Operations return new matrices and never modify their operands.
+type Matrix[T Number] struct {
+	rows, cols int
+	data       []T
+}
+
+// New creates a rows x cols matrix filled with zeros
+func New[T Number](rows, cols int) Matrix[T] {
+	return Matrix[T]{rows: rows, cols: cols, data: make([]T, rows*cols)}
+}
+
+// FromRows creates a matrix from a slice of rows, which must all have the same length
+func FromRows[T Number](rows [][]T) (Matrix[T], error) {
+	if len(rows) == 0 {
+		return Matrix[T]{}, nil
+	}
+
+	m := New[T](len(rows), len(rows[0]))
+	for i, row := range rows {
+		if len(row) != m.cols {
+			return Matrix[T]{}, fmt.Errorf("%w: row %d has %d columns, want %d", ErrRaggedRows, i, len(row), m.cols)
+		}
+		copy(m.data[i*m.cols:], row)
+	}
+	return m, nil
+}
+
+// Rows returns the number of rows
+func (m Matrix[T]) Rows() int {
+	return m.rows
+}
+
+// Cols returns the number of columns
+func (m Matrix[T]) Cols() int {
+	return m.cols
+}
+
+// At returns the element at row i, column j
+func (m Matrix[T]) At(i, j int) T {
+	return m.data[i*m.cols+j]
+}
+
+// Set sets the element at row i, column j
+func (m Matrix[T]) Set(i, j int, v T) {
+	m.data[i*m.cols+j] = v
+}
+
+// ToRows returns the matrix as a slice of rows
+func (m Matrix[T]) ToRows() [][]T {
+	rows := make([][]T, m.rows)
+	for i := range rows {
+		rows[i] = append([]T(nil), m.data[i*m.cols:(i+1)*m.cols]...)
+	}
+	return rows
+}
+
+// Add returns the element-wise sum of m and other, which must have the same dimensions
+func (m Matrix[T]) Add(other Matrix[T]) (Matrix[T], error) {
+	if m.rows != other.rows || m.cols != other.cols {
+		return Matrix[T]{}, fmt.Errorf("%w: cannot add %dx%d and %dx%d", ErrDimensionMismatch, m.rows, m.cols, other.rows, other.cols)
+	}
+
+	sum := New[T](m.rows, m.cols)
+	for i := range m.data {
+		sum.data[i] = m.data[i] + other.data[i]
+	}
+	return sum, nil
+}
+
+// Multiply returns the matrix product m x other.
+// The number of columns in m must equal the number of rows in other.
+// Time Complexity: O(n*m*p) for an n x m by m x p product
+func (m Matrix[T]) Multiply(other Matrix[T]) (Matrix[T], error) {
+	if m.cols != other.rows {
+		return Matrix[T]{}, fmt.Errorf("%w: cannot multiply %dx%d by %dx%d", ErrDimensionMismatch, m.rows, m.cols, other.rows, other.cols)
+	}
+
+	product := New[T](m.rows, other.cols)
+	for i := 0; i < m.rows; i++ {
+		for k := 0; k < m.cols; k++ {
+			a := m.At(i, k)
+			for j := 0; j < other.cols; j++ {
+				product.data[i*product.cols+j] += a * other.At(k, j)
+			}
+		}
+	}
+	return product, nil
+}
+
+// Transpose returns a new matrix with the rows and columns of m swapped
+func (m Matrix[T]) Transpose() Matrix[T] {
+	t := New[T](m.cols, m.rows)
+	for i := 0; i < m.rows; i++ {
+		for j := 0; j < m.cols; j++ {
+			t.Set(j, i, m.At(i, j))
+		}
+	}
+	return t
+}
@@ -0,0 +1,98 @@
+package matrix
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func mustFromRows[T Number](t testing.TB, rows [][]T) Matrix[T] {
+	t.Helper()
+	m, err := FromRows(rows)
+	if err != nil {
+		t.Fatalf("did not expect an error but got one %v", err)
+	}
+	return m
+}
+
+func TestMultiply(t *testing.T) {
+	t.Run("2x3 by 3x2", func(t *testing.T) {
+		a := mustFromRows(t, [][]int{
+			{1, 2, 3},
+			{4, 5, 6},
+		})
+		b := mustFromRows(t, [][]int{
+			{7, 8},
+			{9, 10},
+			{11, 12},
+		})
+
+		got, err := a.Multiply(b)
+		if err != nil {
+			t.Fatalf("did not expect an error but got one %v", err)
+		}
+
+		want := [][]int{
+			{58, 64},
+			{139, 154},
+		}
+		if !reflect.DeepEqual(got.ToRows(), want) {
+			t.Errorf("got %v want %v", got.ToRows(), want)
+		}
+	})
+
+	t.Run("dimension mismatch", func(t *testing.T) {
+		a := New[float64](2, 3)
+		b := New[float64](2, 3)
+
+		_, err := a.Multiply(b)
+		if !errors.Is(err, ErrDimensionMismatch) {
+			t.Errorf("got error %v want %v", err, ErrDimensionMismatch)
+		}
+	})
+}
+
+func TestTranspose(t *testing.T) {
+	rows := [][]float64{
+		{1.5, 2, 3},
+		{4, 5, 6.25},
+	}
+	m := mustFromRows(t, rows)
+
+	transposed := m.Transpose()
+	if transposed.Rows() != 3 || transposed.Cols() != 2 {
+		t.Fatalf("got %dx%d want 3x2", transposed.Rows(), transposed.Cols())
+	}
+	if transposed.At(2, 1) != 6.25 {
+		t.Errorf("got %g want %g", transposed.At(2, 1), 6.25)
+	}
+
+	if got := transposed.Transpose().ToRows(); !reflect.DeepEqual(got, rows) {
+		t.Errorf("round trip got %v want %v", got, rows)
+	}
+}
+
+func TestAdd(t *testing.T) {
+	a := mustFromRows(t, [][]int{{1, 2}, {3, 4}})
+	b := mustFromRows(t, [][]int{{10, 20}, {30, 40}})
+
+	got, err := a.Add(b)
+	if err != nil {
+		t.Fatalf("did not expect an error but got one %v", err)
+	}
+	want := [][]int{{11, 22}, {33, 44}}
+	if !reflect.DeepEqual(got.ToRows(), want) {
+		t.Errorf("got %v want %v", got.ToRows(), want)
+	}
+
+	if _, err := a.Add(New[int](1, 2)); !errors.Is(err, ErrDimensionMismatch) {
+		t.Errorf("got error %v want %v", err, ErrDimensionMismatch)
+	}
+}
+
+func TestFromRowsRagged(t *testing.T) {
+	_, err := FromRows([][]int{{1, 2}, {3}})
+	if !errors.Is(err, ErrRaggedRows) {
+		t.Errorf("got error %v want %v", err, ErrRaggedRows)
+	}
+}
@@ -0,0 +1,66 @@
+// package structures provides general purpose data structures built on the standard library
+package structures
+
+import (
+	"container/heap"
+)
+
+type pqItem[T any] struct {
+	value    T
+	priority int
+	seq      int // insertion order, so equal priorities come out first in first out
+}
+
+// pqHeap implements heap.Interface, it is kept unexported so callers only see the typed API
+type pqHeap[T any] []pqItem[T]
+
+func (h pqHeap[T]) Len() int { return len(h) }
+
+func (h pqHeap[T]) Less(i, j int) bool {
+	if h[i].priority != h[j].priority {
+		return h[i].priority > h[j].priority
+	}
+	return h[i].seq < h[j].seq
+}
+
+func (h pqHeap[T]) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *pqHeap[T]) Push(x any) { *h = append(*h, x.(pqItem[T])) }
+
+func (h *pqHeap[T]) Pop() any {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = pqItem[T]{}
+	*h = old[:n-1]
+	return item
+}
+
+// PriorityQueue is a max priority queue backed by container/heap.
+// Items with a higher priority are popped first, items with equal priority
+// are popped in the order they were pushed.
+// Push and Pop are O(log n).
+type PriorityQueue[T any] struct {
+	items pqHeap[T]
+	seq   int
+}
+
+// Push adds item to the queue with the given priority
+func (pq *PriorityQueue[T]) Push(item T, priority int) {
+	heap.Push(&pq.items, pqItem[T]{value: item, priority: priority, seq: pq.seq})
+	pq.seq++
+}
+
+// Pop removes and returns the highest priority item, or false if the queue is empty
+func (pq *PriorityQueue[T]) Pop() (T, bool) {
+	if len(pq.items) == 0 {
+		var zero T
+		return zero, false
+	}
+	return heap.Pop(&pq.items).(pqItem[T]).value, true
+}
+
+// Len returns the number of items in the queue
+func (pq *PriorityQueue[T]) Len() int {
+	return len(pq.items)
+}
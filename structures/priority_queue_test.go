@@ -0,0 +1,54 @@
+package structures
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPriorityQueue(t *testing.T) {
+	t.Run("pops highest priority first", func(t *testing.T) {
+		pq := &PriorityQueue[string]{}
+		pq.Push("low", 1)
+		pq.Push("urgent", 10)
+		pq.Push("medium", 5)
+		pq.Push("negative", -3)
+		pq.Push("high", 7)
+
+		var got []string
+		for pq.Len() > 0 {
+			item, _ := pq.Pop()
+			got = append(got, item)
+		}
+
+		want := []string{"urgent", "high", "medium", "low", "negative"}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got %v want %v", got, want)
+		}
+	})
+
+	t.Run("equal priorities pop in insertion order", func(t *testing.T) {
+		pq := &PriorityQueue[string]{}
+		pq.Push("first", 1)
+		pq.Push("second", 1)
+		pq.Push("third", 1)
+
+		for _, want := range []string{"first", "second", "third"} {
+			got, _ := pq.Pop()
+			if got != want {
+				t.Errorf("got %q want %q", got, want)
+			}
+		}
+	})
+
+	t.Run("pop on empty queue returns false", func(t *testing.T) {
+		pq := &PriorityQueue[int]{}
+
+		got, ok := pq.Pop()
+		if ok {
+			t.Fatal("expected pop on an empty queue to return false")
+		}
+		if got != 0 {
+			t.Errorf("got %d want zero value", got)
+		}
+	})
+}
@@ -0,0 +1,83 @@
+// package segmenttree provides a segment tree for range sum queries
+package segmenttree
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrOutOfRange = errors.New("index out of range")
+
+// SegmentTree answers sum queries over ranges of a slice and supports point updates.
+// Query and Update are O(log n), building the tree is O(n).
+type SegmentTree struct {
+	n    int
+	tree []int // tree[1] is the root, the children of node i are 2i and 2i+1
+}
+
+// New builds a SegmentTree over a copy of values
+func New(values []int) *SegmentTree {
+	st := &SegmentTree{n: len(values), tree: make([]int, 4*max(len(values), 1))}
+	if st.n > 0 {
+		st.build(values, 1, 0, st.n-1)
+	}
+	return st
+}
+
+// Query returns the sum of the values between l and r inclusive
+func (st *SegmentTree) Query(l, r int) (int, error) {
+	if l < 0 || r >= st.n || l > r {
+		return 0, fmt.Errorf("%w: query [%d, %d] on %d values", ErrOutOfRange, l, r, st.n)
+	}
+	return st.query(1, 0, st.n-1, l, r), nil
+}
+
+// Update sets the value at index i to v
+func (st *SegmentTree) Update(i, v int) error {
+	if i < 0 || i >= st.n {
+		return fmt.Errorf("%w: update %d on %d values", ErrOutOfRange, i, st.n)
+	}
+	st.update(1, 0, st.n-1, i, v)
+	return nil
+}
+
+// Len returns the number of values the tree was built over
+func (st *SegmentTree) Len() int {
+	return st.n
+}
+
+func (st *SegmentTree) build(values []int, node, lo, hi int) {
+	if lo == hi {
+		st.tree[node] = values[lo]
+		return
+	}
+	mid := (lo + hi) / 2
+	st.build(values, 2*node, lo, mid)
+	st.build(values, 2*node+1, mid+1, hi)
+	st.tree[node] = st.tree[2*node] + st.tree[2*node+1]
+}
+
+func (st *SegmentTree) query(node, lo, hi, l, r int) int {
+	if r < lo || hi < l {
+		return 0
+	}
+	if l <= lo && hi <= r {
+		return st.tree[node]
+	}
+	mid := (lo + hi) / 2
+	return st.query(2*node, lo, mid, l, r) + st.query(2*node+1, mid+1, hi, l, r)
+}
+
+func (st *SegmentTree) update(node, lo, hi, i, v int) {
+	if lo == hi {
+		st.tree[node] = v
+		return
+	}
+	mid := (lo + hi) / 2
+	if i <= mid {
+		st.update(2*node, lo, mid, i, v)
+	} else {
+		st.update(2*node+1, mid+1, hi, i, v)
+	}
+	st.tree[node] = st.tree[2*node] + st.tree[2*node+1]
+}
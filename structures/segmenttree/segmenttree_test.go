@@ -0,0 +1,82 @@
+package segmenttree
+
+import (
+	"errors"
+	"testing"
+)
+
+func bruteForceSum(values []int, l, r int) int {
+	sum := 0
+	for i := l; i <= r; i++ {
+		sum += values[i]
+	}
+	return sum
+}
+
+func TestQuery(t *testing.T) {
+	values := []int{5, -2, 7, 0, 3, 9, -4, 1}
+	st := New(values)
+
+	for l := 0; l < len(values); l++ {
+		for r := l; r < len(values); r++ {
+			got, err := st.Query(l, r)
+			if err != nil {
+				t.Fatalf("did not expect an error but got one %v", err)
+			}
+			if want := bruteForceSum(values, l, r); got != want {
+				t.Errorf("Query(%d, %d) got %d want %d", l, r, got, want)
+			}
+		}
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	values := []int{1, 2, 3, 4, 5}
+	st := New(values)
+
+	if err := st.Update(2, 10); err != nil {
+		t.Fatalf("did not expect an error but got one %v", err)
+	}
+	values[2] = 10
+
+	for _, q := range [][2]int{{0, 4}, {2, 2}, {1, 3}, {3, 4}} {
+		got, _ := st.Query(q[0], q[1])
+		if want := bruteForceSum(values, q[0], q[1]); got != want {
+			t.Errorf("Query(%d, %d) got %d want %d", q[0], q[1], got, want)
+		}
+	}
+}
+
+func TestOutOfRange(t *testing.T) {
+	st := New([]int{1, 2, 3})
+
+	queries := []struct {
+		name string
+		l, r int
+	}{
+		{"negative start", -1, 1},
+		{"end past the last index", 0, 3},
+		{"start after end", 2, 1},
+	}
+
+	for _, tt := range queries {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := st.Query(tt.l, tt.r)
+			if !errors.Is(err, ErrOutOfRange) {
+				t.Errorf("got error %v want %v", err, ErrOutOfRange)
+			}
+		})
+	}
+
+	t.Run("update past the last index", func(t *testing.T) {
+		if err := st.Update(3, 1); !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("got error %v want %v", err, ErrOutOfRange)
+		}
+	})
+
+	t.Run("query on an empty tree", func(t *testing.T) {
+		if _, err := New(nil).Query(0, 0); !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("got error %v want %v", err, ErrOutOfRange)
+		}
+	})
+}
@@ -0,0 +1,126 @@
+// package skiplist provides a probabilistic ordered set
+package skiplist
+
+import (
+	"math/rand"
+	"time"
+
+	"golang.org/x/exp/constraints"
+)
+
+const (
+	maxLevel = 16
+	// probability of a node being promoted to the next level
+	promote = 0.5
+)
+
+type node[T constraints.Ordered] struct {
+	value T
+	next  []*node[T]
+}
+
+// SkipList is an ordered set of unique values. Each node is linked on a random
+// number of levels, the higher levels act as express lanes that let searches
+// skip over most of the list.
+// Insert, Contains and Delete are O(log n) on average.
+type SkipList[T constraints.Ordered] struct {
+	head   *node[T]
+	level  int
+	length int
+	rand   *rand.Rand
+}
+
+// New creates an empty SkipList that uses r to pick node levels.
+// Passing a seeded source makes the structure deterministic, nil uses a time seeded one.
+func New[T constraints.Ordered](r *rand.Rand) *SkipList[T] {
+	if r == nil {
+		r = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+	return &SkipList[T]{
+		head:  &node[T]{next: make([]*node[T], maxLevel)},
+		level: 1,
+		rand:  r,
+	}
+}
+
+// Insert adds v to the list, inserting a value that is already present does nothing
+func (s *SkipList[T]) Insert(v T) {
+	update := s.findPredecessors(v)
+	if next := update[0].next[0]; next != nil && next.value == v {
+		return
+	}
+
+	level := s.randomLevel()
+	if level > s.level {
+		for i := s.level; i < level; i++ {
+			update[i] = s.head
+		}
+		s.level = level
+	}
+
+	n := &node[T]{value: v, next: make([]*node[T], level)}
+	for i := 0; i < level; i++ {
+		n.next[i] = update[i].next[i]
+		update[i].next[i] = n
+	}
+	s.length++
+}
+
+// Contains reports whether v is in the list
+func (s *SkipList[T]) Contains(v T) bool {
+	next := s.findPredecessors(v)[0].next[0]
+	return next != nil && next.value == v
+}
+
+// Delete removes v from the list and reports whether it was present
+func (s *SkipList[T]) Delete(v T) bool {
+	update := s.findPredecessors(v)
+	target := update[0].next[0]
+	if target == nil || target.value != v {
+		return false
+	}
+
+	for i := 0; i < len(target.next); i++ {
+		update[i].next[i] = target.next[i]
+	}
+	for s.level > 1 && s.head.next[s.level-1] == nil {
+		s.level--
+	}
+	s.length--
+	return true
+}
+
+// InOrder returns the values in ascending order
+func (s *SkipList[T]) InOrder() []T {
+	values := make([]T, 0, s.length)
+	for n := s.head.next[0]; n != nil; n = n.next[0] {
+		values = append(values, n.value)
+	}
+	return values
+}
+
+// Len returns the number of values in the list
+func (s *SkipList[T]) Len() int {
+	return s.length
+}
+
+// findPredecessors returns, for every level, the last node whose value is less than v
+func (s *SkipList[T]) findPredecessors(v T) []*node[T] {
+	update := make([]*node[T], maxLevel)
+	n := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for n.next[i] != nil && n.next[i].value < v {
+			n = n.next[i]
+		}
+		update[i] = n
+	}
+	return update
+}
+
+func (s *SkipList[T]) randomLevel() int {
+	level := 1
+	for level < maxLevel && s.rand.Float64() < promote {
+		level++
+	}
+	return level
+}
@@ -0,0 +1,83 @@
+package skiplist
+
+import (
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestSkipList(t *testing.T) {
+	t.Run("in order traversal is sorted after many inserts", func(t *testing.T) {
+		r := rand.New(rand.NewSource(42))
+		s := New[int](r)
+
+		seen := map[int]bool{}
+		var want []int
+		for i := 0; i < 1000; i++ {
+			v := r.Intn(5000) - 2500
+			s.Insert(v)
+			if !seen[v] {
+				seen[v] = true
+				want = append(want, v)
+			}
+		}
+		slices.Sort(want)
+
+		got := s.InOrder()
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got %v want %v", got, want)
+		}
+		if s.Len() != len(want) {
+			t.Errorf("got len %d want %d", s.Len(), len(want))
+		}
+	})
+
+	t.Run("contains finds inserted values only", func(t *testing.T) {
+		s := New[string](rand.New(rand.NewSource(1)))
+		s.Insert("banana")
+		s.Insert("apple")
+
+		if !s.Contains("apple") || !s.Contains("banana") {
+			t.Error("expected inserted values to be found")
+		}
+		if s.Contains("cherry") {
+			t.Error("did not expect cherry to be found")
+		}
+	})
+
+	t.Run("delete removes elements", func(t *testing.T) {
+		s := New[int](rand.New(rand.NewSource(7)))
+		for i := 1; i <= 10; i++ {
+			s.Insert(i)
+		}
+
+		for _, v := range []int{1, 5, 10} {
+			if !s.Delete(v) {
+				t.Errorf("expected %d to be deleted", v)
+			}
+			if s.Contains(v) {
+				t.Errorf("did not expect %d to be found after delete", v)
+			}
+		}
+		if s.Delete(5) {
+			t.Error("deleting a missing value should return false")
+		}
+
+		want := []int{2, 3, 4, 6, 7, 8, 9}
+		if got := s.InOrder(); !reflect.DeepEqual(got, want) {
+			t.Errorf("got %v want %v", got, want)
+		}
+	})
+
+	t.Run("empty list", func(t *testing.T) {
+		s := New[int](nil)
+
+		if got := s.InOrder(); len(got) != 0 {
+			t.Errorf("got %v want empty", got)
+		}
+		if s.Delete(1) {
+			t.Error("deleting from an empty list should return false")
+		}
+	})
+}
@@ -0,0 +1,76 @@
+// package unionfind provides a disjoint set (union-find) structure
+package unionfind
+
+// UnionFind tracks a collection of disjoint sets. Elements are added on first use.
+// It uses path compression and union by rank, so Find and Union run in
+// near constant amortized time.
+type UnionFind[T comparable] struct {
+	parent map[T]T
+	rank   map[T]int
+	sets   int
+}
+
+// New creates an empty UnionFind
+func New[T comparable]() *UnionFind[T] {
+	return &UnionFind[T]{
+		parent: make(map[T]T),
+		rank:   make(map[T]int),
+	}
+}
+
+// Add puts x in its own set, adding an element that is already present does nothing
+func (u *UnionFind[T]) Add(x T) {
+	if _, ok := u.parent[x]; ok {
+		return
+	}
+	u.parent[x] = x
+	u.sets++
+}
+
+// Find returns the representative of the set containing x, adding x if needed
+func (u *UnionFind[T]) Find(x T) T {
+	u.Add(x)
+
+	root := x
+	for u.parent[root] != root {
+		root = u.parent[root]
+	}
+	// path compression, point everything on the way straight at the root
+	for x != root {
+		next := u.parent[x]
+		u.parent[x] = root
+		x = next
+	}
+	return root
+}
+
+// Union merges the sets containing a and b. It returns false if they were already in the same set.
+func (u *UnionFind[T]) Union(a, b T) bool {
+	ra, rb := u.Find(a), u.Find(b)
+	if ra == rb {
+		return false
+	}
+
+	// attach the shorter tree under the taller one
+	switch {
+	case u.rank[ra] < u.rank[rb]:
+		u.parent[ra] = rb
+	case u.rank[ra] > u.rank[rb]:
+		u.parent[rb] = ra
+	default:
+		u.parent[rb] = ra
+		u.rank[ra]++
+	}
+	u.sets--
+	return true
+}
+
+// Connected reports whether a and b are in the same set
+func (u *UnionFind[T]) Connected(a, b T) bool {
+	return u.Find(a) == u.Find(b)
+}
+
+// Sets returns the number of disjoint sets
+func (u *UnionFind[T]) Sets() int {
+	return u.sets
+}
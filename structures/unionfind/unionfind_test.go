@@ -0,0 +1,55 @@
+package unionfind
+
+import (
+	"testing"
+)
+
+func TestUnionFind(t *testing.T) {
+	t.Run("new elements are in their own set", func(t *testing.T) {
+		u := New[string]()
+		u.Add("a")
+		u.Add("b")
+
+		if u.Connected("a", "b") {
+			t.Error("did not expect a and b to be connected")
+		}
+		assertSets(t, u, 2)
+	})
+
+	t.Run("union connects sets transitively", func(t *testing.T) {
+		u := New[int]()
+		for i := 1; i <= 5; i++ {
+			u.Add(i)
+		}
+
+		u.Union(1, 2)
+		u.Union(3, 4)
+		u.Union(2, 4)
+
+		if !u.Connected(1, 3) {
+			t.Error("expected 1 and 3 to be connected")
+		}
+		if u.Connected(1, 5) {
+			t.Error("did not expect 1 and 5 to be connected")
+		}
+		assertSets(t, u, 2)
+	})
+
+	t.Run("union within a set returns false", func(t *testing.T) {
+		u := New[int]()
+		if !u.Union(1, 2) {
+			t.Error("expected the first union to merge two sets")
+		}
+		if u.Union(2, 1) {
+			t.Error("expected a repeated union to return false")
+		}
+		assertSets(t, u, 1)
+	})
+}
+
+func assertSets[T comparable](t testing.TB, u *UnionFind[T], want int) {
+	t.Helper()
+	if got := u.Sets(); got != want {
+		t.Errorf("got %d sets, want %d", got, want)
+	}
+}